@@ -33,7 +33,7 @@ func (c *clientCmd) Run(cmdCtx *cmdContext) error {
 	g := run.Group{}
 
 	// listen for termination signals
-	osSigChan := make(chan os.Signal)
+	osSigChan := make(chan os.Signal, 1)
 	signal.Notify(osSigChan, os.Kill, os.Interrupt)
 	done := make(chan struct{})
 	g.Add(func() error {
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/mwasilew2/go-service-template/internal/adapters/namesdb"
+	"github.com/mwasilew2/go-service-template/internal/domain/compatibility"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+type compatibilityCmd struct {
+	// cli options
+	Surname string   `help:"surname to check the names with" required:""`
+	Names   []string `arg:"" help:"first names to check"`
+	Format  string   `help:"output format" enum:"text,json" default:"text"`
+	DataDir string   `help:"directory with per-year name datasets (*.csv) and optionally metadata of names (metadata.json), the embedded ones are used if not set" type:"existingdir" env:"DATA_DIR"`
+
+	// Dependencies
+	logger *slog.Logger
+}
+
+func (c *compatibilityCmd) Run(cmdCtx *cmdContext) error {
+	c.logger = cmdCtx.Logger.With("component", "compatibilityCmd")
+
+	// initialize dependencies
+	namesDB, err := namesdb.NewNamesDB(c.DataDir)
+	if err != nil {
+		return fmt.Errorf("failed to initialize names service: %w", err)
+	}
+	compatibilityService := compatibility.NewService(namesDB)
+
+	// check names
+	results, err := compatibilityService.Check(context.Background(), c.Surname, c.Names)
+	if err != nil {
+		return fmt.Errorf("failed to check compatibility: %w", err)
+	}
+	c.logger.Debug("checked compatibility", "surname", c.Surname, "names", len(c.Names))
+
+	if c.Format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+	}
+	return writeCompatibility(os.Stdout, results)
+}
+
+func writeCompatibility(out io.Writer, results []*models.Compatibility) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSURNAME\tKNOWN\tINITIALS\tRHYTHM\tWARNINGS")
+	for _, result := range results {
+		warnings := "-"
+		if len(result.Warnings) > 0 {
+			warnings = strings.Join(result.Warnings, "; ")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\t%s\n",
+			result.Value,
+			result.Surname,
+			result.Name != nil,
+			result.Initials,
+			result.Rhythm,
+			warnings,
+		)
+	}
+	return w.Flush()
+}
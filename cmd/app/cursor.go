@@ -0,0 +1,78 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+var ErrCursorMismatch = errors.New("cursor was issued for a different year or gender")
+var ErrCursorWithPage = errors.New("cursor and page can't be used together")
+var ErrCursorWithFilters = errors.New("cursor can't be used together with filters other than gender or with sort")
+
+// nameCursor points at a position in the list of names of a year. Positions are anchored at ids rather than offsets,
+// so that a cursor keeps pointing at the same place in the list after datasets are reloaded.
+type nameCursor struct {
+	Year int64 `json:"y"`
+	// empty for names of all genders
+	Gender models.Gender `json:"g,omitempty"`
+	// the page starts right after this id, or ends right before it if Before is set
+	Id     int64 `json:"i"`
+	Before bool  `json:"b,omitempty"`
+	Limit  int64 `json:"l"`
+}
+
+// cursorCodec turns cursors into opaque tokens signed with HMAC-SHA256, so that clients can't forge them
+type cursorCodec struct {
+	key []byte
+}
+
+func newCursorCodec(key []byte) *cursorCodec {
+	return &cursorCodec{
+		key: key,
+	}
+}
+
+func (c *cursorCodec) encode(cursor nameCursor) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(c.sign(payload)), nil
+}
+
+func (c *cursorCodec) decode(token string) (nameCursor, error) {
+	var cursor nameCursor
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return cursor, ErrInvalidCursor
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return cursor, ErrInvalidCursor
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return cursor, ErrInvalidCursor
+	}
+	if !hmac.Equal(signature, c.sign(payload)) {
+		return cursor, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return cursor, ErrInvalidCursor
+	}
+	return cursor, nil
+}
+
+func (c *cursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
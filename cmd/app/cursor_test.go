@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slog"
+
+	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+	"github.com/mwasilew2/go-service-template/internal/adapters/namesdb"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+func TestCursorCodecRoundTrip(t *testing.T) {
+	codec := newCursorCodec([]byte("secret"))
+	tests := []struct {
+		name   string
+		cursor nameCursor
+	}{
+		{"after an id", nameCursor{Year: 2023, Id: 10, Limit: 20}},
+		{"before an id", nameCursor{Year: 2023, Id: 10, Before: true, Limit: 5}},
+		{"of a gender", nameCursor{Year: 2022, Gender: models.GenderFemale, Id: 0, Limit: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := codec.encode(tt.cursor)
+			if err != nil {
+				t.Fatalf("failed to encode cursor: %v", err)
+			}
+			got, err := codec.decode(token)
+			if err != nil {
+				t.Fatalf("failed to decode cursor: %v", err)
+			}
+			if got != tt.cursor {
+				t.Errorf("expected %+v, got %+v", tt.cursor, got)
+			}
+		})
+	}
+}
+
+func TestCursorCodecRejectsForgedTokens(t *testing.T) {
+	codec := newCursorCodec([]byte("secret"))
+	token, err := codec.encode(nameCursor{Year: 2023, Id: 10, Limit: 20})
+	if err != nil {
+		t.Fatalf("failed to encode cursor: %v", err)
+	}
+	payload, signature, _ := strings.Cut(token, ".")
+	otherSecret, err := newCursorCodec([]byte("other secret")).encode(nameCursor{Year: 2023, Id: 10, Limit: 20})
+	if err != nil {
+		t.Fatalf("failed to encode cursor: %v", err)
+	}
+	// a payload pointing elsewhere, signed with the signature of the original one
+	forgedPayload := base64.RawURLEncoding.EncodeToString([]byte(`{"y":2023,"i":500,"l":20}`))
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"without a signature", payload},
+		{"trailing byte", token + "A"},
+		{"tampered signature", payload + "." + flipFirst(signature)},
+		{"tampered payload", forgedPayload + "." + signature},
+		{"signed with a different secret", otherSecret},
+		{"invalid base64", "!!!." + signature},
+		{"signed payload which isn't a cursor", signedGarbage(codec)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := codec.decode(tt.token); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("expected %v, got %v", ErrInvalidCursor, err)
+			}
+		})
+	}
+}
+
+func TestGetV1NameRejectsCursorWithPage(t *testing.T) {
+	namesDB, err := namesdb.NewNamesDB("")
+	if err != nil {
+		t.Fatalf("failed to load embedded dataset: %v", err)
+	}
+	c := &serverCmd{
+		logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+		namesService: namesDB,
+		cursors:      newCursorCodec([]byte("secret")),
+	}
+	token, err := c.cursors.encode(nameCursor{Year: 2023, Id: 10, Limit: 20})
+	if err != nil {
+		t.Fatalf("failed to encode cursor: %v", err)
+	}
+	page, otherYear, female := int64(1), int64(2022), server_oapi.Gender(models.GenderFemale)
+
+	tests := []struct {
+		name    string
+		params  server_oapi.GetV1NameParams
+		wantErr error
+	}{
+		{"cursor and page", server_oapi.GetV1NameParams{Cursor: &token, Page: &page}, ErrCursorWithPage},
+		{"cursor of a different year", server_oapi.GetV1NameParams{Cursor: &token, Year: &otherYear}, ErrCursorMismatch},
+		{"cursor of a different gender", server_oapi.GetV1NameParams{Cursor: &token, Gender: &female}, ErrCursorMismatch},
+		{"cursor alone", server_oapi.GetV1NameParams{Cursor: &token}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.GetV1Name(context.Background(), server_oapi.GetV1NameRequestObject{Params: tt.params})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// flipFirst replaces the first character of a base64 string with another valid one
+func flipFirst(s string) string {
+	if s[0] == 'A' {
+		return "B" + s[1:]
+	}
+	return "A" + s[1:]
+}
+
+func signedGarbage(codec *cursorCodec) string {
+	payload := []byte("not json")
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(codec.sign(payload))
+}
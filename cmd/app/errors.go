@@ -0,0 +1,289 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+	"github.com/mwasilew2/go-service-template/internal/domain/compatibility"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+	"github.com/mwasilew2/go-service-template/internal/domain/sessions"
+	"github.com/mwasilew2/go-service-template/internal/domain/tournaments"
+	"github.com/mwasilew2/go-service-template/internal/domain/trends"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrIncorrectYearParameter = errors.New("invalid year parameter")
+var ErrIncorrectLimitParameter = errors.New("incorrect request parameters, limit must be >= 0")
+var ErrIncorrectPageParameter = errors.New("incorrect request parameters, page must be >= 0")
+var ErrPageOutOfRange = errors.New("incorrect request parameters, page*limit must be <= count")
+var ErrIncorrectCountParameter = fmt.Errorf("incorrect request parameters, count must be between 0 and %d", maxRandomCount)
+var ErrIncorrectChunkSizeParameter = fmt.Errorf("incorrect request parameters, chunk size must be between 0 and %d", maxStreamChunkSize)
+
+const (
+	problemContentType = "application/problem+json"
+	// problem types are URNs, they identify problems, but aren't meant to be dereferenced
+	problemTypePrefix = "urn:namer:problem:"
+)
+
+// errorTranslation describes how an error is reported to http and grpc clients
+type errorTranslation struct {
+	err        error
+	httpStatus int
+	grpcCode   codes.Code
+	// identifies the problem in problem+json responses
+	problemType string
+	// message returned to clients, the error itself is used if empty
+	message string
+	// the request parameter which caused the error, if any
+	parameter string
+	// where the parameter is, query if empty
+	in server_oapi.FieldErrorIn
+}
+
+// errorTranslations are checked in order, the first one matching an error with errors.Is wins
+var errorTranslations = []errorTranslation{
+	{err: ports.ErrYearNotFound, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "year-not-available", message: "year not available", parameter: "year"},
+	{err: ports.ErrNameNotFound, httpStatus: http.StatusNotFound, grpcCode: codes.NotFound, problemType: "name-not-found", message: "name not found"},
+	{err: ports.ErrShortlistNotFound, httpStatus: http.StatusNotFound, grpcCode: codes.NotFound, problemType: "shortlist-not-found"},
+	{err: ports.ErrSessionNotFound, httpStatus: http.StatusNotFound, grpcCode: codes.NotFound, problemType: "session-not-found"},
+	{err: sessions.ErrUnknownParticipant, httpStatus: http.StatusForbidden, grpcCode: codes.PermissionDenied, problemType: "unknown-participant", parameter: "token"},
+	{err: ports.ErrTournamentNotFound, httpStatus: http.StatusNotFound, grpcCode: codes.NotFound, problemType: "tournament-not-found"},
+	{err: tournaments.ErrNotEnoughContenders, httpStatus: http.StatusUnprocessableEntity, grpcCode: codes.FailedPrecondition, problemType: "not-enough-contenders"},
+	{err: tournaments.ErrUnknownContender, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "unknown-contender"},
+	{err: tournaments.ErrMissingParticipant, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-parameter", parameter: "participant"},
+	{err: ErrIncorrectYearParameter, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-parameter", parameter: "year"},
+	{err: ErrIncorrectLimitParameter, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-parameter", parameter: "limit"},
+	{err: ErrIncorrectPageParameter, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-parameter", parameter: "page"},
+	{err: ErrIncorrectCountParameter, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-parameter", parameter: "count"},
+	{err: ErrIncorrectChunkSizeParameter, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-parameter", parameter: "chunk_size"},
+	{err: ErrPageOutOfRange, httpStatus: http.StatusUnprocessableEntity, grpcCode: codes.OutOfRange, problemType: "page-out-of-range", parameter: "page"},
+	{err: ErrInvalidCursor, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-cursor", parameter: "cursor"},
+	{err: ErrCursorMismatch, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-cursor", parameter: "cursor"},
+	{err: ErrCursorWithPage, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-cursor", parameter: "cursor"},
+	{err: ErrCursorWithFilters, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-cursor", parameter: "cursor"},
+	{err: models.ErrInvalidNameDay, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-parameter", parameter: "date"},
+	{err: compatibility.ErrEmptySurname, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-parameter", parameter: "surname", in: server_oapi.Body},
+	{err: compatibility.ErrNoNames, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-parameter", parameter: "names", in: server_oapi.Body},
+	{err: trends.ErrUnknownMetric, httpStatus: http.StatusBadRequest, grpcCode: codes.InvalidArgument, problemType: "invalid-parameter", parameter: "by"},
+	{err: trends.ErrSameYears, httpStatus: http.StatusUnprocessableEntity, grpcCode: codes.InvalidArgument, problemType: "same-years"},
+}
+
+var internalErrorTranslation = errorTranslation{
+	httpStatus:  http.StatusInternalServerError,
+	grpcCode:    codes.Internal,
+	problemType: "internal-error",
+	message:     "internal server error",
+}
+
+// translateError finds out how an error should be reported to clients, errors which aren't known are internal errors
+func translateError(err error) errorTranslation {
+	for _, translation := range errorTranslations {
+		if errors.Is(err, translation.err) {
+			if translation.message == "" {
+				translation.message = translation.err.Error()
+			}
+			return translation
+		}
+	}
+	return internalErrorTranslation
+}
+
+// translateErrors is a strict handler middleware which turns errors returned by handlers into error responses
+func (c *serverCmd) translateErrors(f server_oapi.StrictHandlerFunc, operationID string) server_oapi.StrictHandlerFunc {
+	return func(ctx echo.Context, request interface{}) (interface{}, error) {
+		response, err := f(ctx, request)
+		if err == nil {
+			return response, nil
+		}
+		translation := translateError(err)
+		if translation.httpStatus == http.StatusInternalServerError {
+			c.logger.Error("request failed", "operation", operationID, "error", err)
+		} else {
+			c.logger.Debug("request rejected", "operation", operationID, "error", err)
+		}
+
+		problem := newProblem(ctx, translation.httpStatus, translation.problemType, translation.message)
+		if translation.parameter != "" {
+			in := translation.in
+			if in == "" {
+				in = server_oapi.Query
+			}
+			problem.Errors = &[]server_oapi.FieldError{{
+				Name:    translation.parameter,
+				In:      in,
+				Message: translation.message,
+			}}
+		}
+		return nil, writeProblem(ctx, problem)
+	}
+}
+
+// handleValidationError is an error handler of the request validator, it describes every invalid parameter
+func handleValidationError(ctx echo.Context, httpErr *echo.HTTPError) error {
+	problemType := "validation-error"
+	detail := fmt.Sprint(httpErr.Message)
+	switch httpErr.Code {
+	case http.StatusNotFound:
+		problemType = "route-not-found"
+	case http.StatusInternalServerError:
+		problemType = "internal-error"
+	}
+	problem := newProblem(ctx, httpErr.Code, problemType, detail)
+
+	var fieldErrors []server_oapi.FieldError
+	var multiErr openapi3.MultiError
+	if errors.As(httpErr.Internal, &multiErr) {
+		for _, err := range multiErr {
+			fieldErrors = append(fieldErrors, toFieldErrors(err)...)
+		}
+	} else if httpErr.Internal != nil {
+		fieldErrors = toFieldErrors(httpErr.Internal)
+	}
+	if len(fieldErrors) > 0 {
+		problem.Errors = &fieldErrors
+		if len(fieldErrors) == 1 {
+			problem.Detail = &fieldErrors[0].Message
+		}
+	}
+	return writeProblem(ctx, problem)
+}
+
+// validationMultiErrorHandler keeps all validation errors, so that handleValidationError can describe each of them
+func validationMultiErrorHandler(me openapi3.MultiError) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:     http.StatusBadRequest,
+		Message:  "request parameters are invalid",
+		Internal: me,
+	}
+}
+
+func toFieldErrors(err error) []server_oapi.FieldError {
+	var requestErr *openapi3filter.RequestError
+	if !errors.As(err, &requestErr) {
+		return nil
+	}
+	message := requestErr.Reason
+	if requestErr.Err != nil {
+		// schema errors are multi-line, the first line describes the problem
+		message = strings.SplitN(requestErr.Err.Error(), "\n", 2)[0]
+	}
+	switch {
+	case requestErr.Parameter != nil:
+		return []server_oapi.FieldError{{
+			Name:    requestErr.Parameter.Name,
+			In:      server_oapi.FieldErrorIn(requestErr.Parameter.In),
+			Message: message,
+		}}
+	case requestErr.RequestBody != nil:
+		return []server_oapi.FieldError{{
+			Name:    "body",
+			In:      server_oapi.Body,
+			Message: message,
+		}}
+	default:
+		return nil
+	}
+}
+
+func newProblem(ctx echo.Context, httpStatus int, problemType string, detail string) server_oapi.Problem {
+	// the URI the client sent, the request validator adds defaults of missing parameters to the parsed URL
+	instance := ctx.Request().RequestURI
+	if instance == "" {
+		instance = ctx.Request().URL.RequestURI()
+	}
+	return server_oapi.Problem{
+		Type:     problemTypePrefix + problemType,
+		Title:    http.StatusText(httpStatus),
+		Status:   int32(httpStatus),
+		Detail:   &detail,
+		Instance: &instance,
+	}
+}
+
+// writeProblem responds with problem+json, or with the legacy error object if the client prefers application/json
+func writeProblem(ctx echo.Context, problem server_oapi.Problem) error {
+	if !prefersProblem(ctx.Request().Header.Get(echo.HeaderAccept)) {
+		message := problem.Title
+		if problem.Detail != nil {
+			message = *problem.Detail
+		}
+		return ctx.JSON(int(problem.Status), server_oapi.Error{
+			Code:    problem.Status,
+			Message: message,
+		})
+	}
+	body, err := json.Marshal(problem)
+	if err != nil {
+		return fmt.Errorf("failed to marshal problem: %w", err)
+	}
+	return ctx.Blob(int(problem.Status), problemContentType, body)
+}
+
+// prefersProblem tells if problem+json should be returned to a client with a given Accept header. The media type with
+// a higher quality wins, ties are broken by how specific the matching media ranges are. problem+json is preferred
+// if the client doesn't express a preference, e.g. accepts */*, but never if it isn't acceptable, e.g. has q=0.
+func prefersProblem(accept string) bool {
+	problemQuality, problemSpecificity := acceptQuality(accept, problemContentType)
+	jsonQuality, jsonSpecificity := acceptQuality(accept, echo.MIMEApplicationJSON)
+	if problemQuality == 0 {
+		return false
+	}
+	if problemQuality != jsonQuality {
+		return problemQuality > jsonQuality
+	}
+	return problemSpecificity >= jsonSpecificity
+}
+
+// acceptQuality returns the quality of a media type given by the most specific matching range of an Accept header
+// and how specific the range is: 0 for */*, 1 for type/*, 2 for type/subtype. A missing header accepts everything.
+func acceptQuality(accept string, mediaType string) (float64, int) {
+	if strings.TrimSpace(accept) == "" {
+		return 1, 0
+	}
+	quality, specificity := 0.0, -1
+	for _, mediaRange := range strings.Split(accept, ",") {
+		rangeType, params, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+		var rangeSpecificity int
+		switch {
+		case rangeType == mediaType:
+			rangeSpecificity = 2
+		case rangeType == strings.SplitN(mediaType, "/", 2)[0]+"/*":
+			rangeSpecificity = 1
+		case rangeType == "*/*":
+			rangeSpecificity = 0
+		default:
+			continue
+		}
+		if rangeSpecificity <= specificity {
+			continue
+		}
+		specificity = rangeSpecificity
+		quality = 1
+		if q, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+	}
+	return quality, specificity
+}
+
+// toGrpcError translates errors into grpc statuses matching the http responses of the REST API
+func toGrpcError(err error) error {
+	translation := translateError(err)
+	return status.Error(translation.grpcCode, translation.message)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestPrefersProblem(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{"", true},
+		{"*/*", true},
+		{"application/*", true},
+		{"application/problem+json", true},
+		{"application/json", false},
+		{"application/json, application/problem+json", true},
+		{"application/json, */*", false},
+		{"application/problem+json, */*;q=0.1", true},
+		{"application/json;q=0.5, */*", true},
+		{"application/json, */*;q=0.1", false},
+		{"application/json;q=0.9, application/problem+json;q=0.5", false},
+		{"application/json;q=0.5, application/problem+json;q=0.9", true},
+		{"application/problem+json;q=0", false},
+		{"application/problem+json;q=0, application/json", false},
+		{"application/problem+json;q=0, */*", false},
+		{"APPLICATION/JSON", false},
+		{"text/html", false},
+		{"not a media type, application/problem+json", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			if got := prefersProblem(tt.accept); got != tt.want {
+				t.Errorf("expected %v for Accept %q, got %v", tt.want, tt.accept, got)
+			}
+		})
+	}
+}
+
+func TestWriteProblem(t *testing.T) {
+	tests := []struct {
+		name            string
+		accept          string
+		wantContentType string
+	}{
+		{"without Accept", "", problemContentType},
+		{"accepting problem+json", "application/problem+json", problemContentType},
+		{"accepting only json", "application/json", echo.MIMEApplicationJSONCharsetUTF8},
+		{"refusing problem+json", "application/problem+json;q=0, */*", echo.MIMEApplicationJSONCharsetUTF8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/name?year=1999", nil)
+			if tt.accept != "" {
+				req.Header.Set(echo.HeaderAccept, tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(req, rec)
+			if err := writeProblem(ctx, newProblem(ctx, http.StatusBadRequest, "invalid-parameter", "invalid year")); err != nil {
+				t.Fatalf("failed to write problem: %v", err)
+			}
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+			}
+			if got := rec.Header().Get(echo.HeaderContentType); got != tt.wantContentType {
+				t.Errorf("expected content type %q, got %q", tt.wantContentType, got)
+			}
+		})
+	}
+}
+
+func TestProblemInstanceIsTheRequestedURI(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/name?year=1999", nil)
+	// the request validator adds defaults of missing parameters to the parsed URL
+	req.URL.RawQuery += "&order=asc&sort=id"
+	ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+	problem := newProblem(ctx, http.StatusBadRequest, "invalid-parameter", "invalid year")
+	if problem.Instance == nil || *problem.Instance != "/api/v1/name?year=1999" {
+		t.Errorf("expected instance %q, got %v", "/api/v1/name?year=1999", problem.Instance)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/mwasilew2/go-service-template/internal/adapters/namesdb"
+	"github.com/mwasilew2/go-service-template/internal/adapters/sqlitedb"
+)
+
+type importCmd struct {
+	// cli options
+	DataDir  string `help:"directory with per-year name datasets (*.csv) and optionally metadata of names (metadata.json), the embedded ones are used if not set" type:"existingdir" env:"DATA_DIR"`
+	Database string `help:"SQLite database which the names should be imported into, created if it doesn't exist" type:"path" default:"./names.db" env:"DATABASE"`
+
+	// Dependencies
+	logger *slog.Logger
+}
+
+func (c *importCmd) Run(cmdCtx *cmdContext) error {
+	c.logger = cmdCtx.Logger.With("component", "importCmd")
+
+	// initialize dependencies
+	namesDB, err := namesdb.NewNamesDB(c.DataDir)
+	if err != nil {
+		return fmt.Errorf("failed to load datasets: %w", err)
+	}
+	store, err := sqlitedb.NewSQLiteNamesDB(c.Database)
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer store.Close()
+
+	// import every year found in the datasets
+	ctx := context.Background()
+	available, err := namesDB.GetYearsAvailable(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get years available: %w", err)
+	}
+	years := make([]int64, 0, len(available))
+	for year := range available {
+		years = append(years, year)
+	}
+	sort.Slice(years, func(i, j int) bool { return years[i] < years[j] })
+
+	imported, err := store.Import(ctx, namesDB, years)
+	if err != nil {
+		return fmt.Errorf("failed to import names: %w", err)
+	}
+	c.logger.Info("imported names", "database", c.Database, "years", years, "names", imported)
+	return nil
+}
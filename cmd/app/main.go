@@ -23,9 +23,12 @@ type Globals struct {
 var kongApp struct {
 	Globals
 
-	Server    serverCmd    `cmd:"" help:"Start the app server."`
-	Client    clientCmd    `cmd:"" help:"Start the app client."`
-	Transform transformCmd `cmd:"" help:"Transform statistical data into a format easily digestable by an executable."`
+	Server        serverCmd        `cmd:"" help:"Start the app server."`
+	Client        clientCmd        `cmd:"" help:"Start the app client."`
+	Transform     transformCmd     `cmd:"" help:"Transform statistical data into a format easily digestable by an executable."`
+	Trends        trendsCmd        `cmd:"" help:"Print names which gained or lost the most popularity between two years."`
+	Compatibility compatibilityCmd `cmd:"" help:"Print how first names sound together with a surname."`
+	Import        importCmd        `cmd:"" help:"Import transformed datasets into an SQLite database served with --storage=sqlite."`
 }
 
 func main() {
@@ -2,13 +2,15 @@ package main
 
 import (
 	"context"
-	"errors"
+	"crypto/rand"
 	"fmt"
+	mathrand "math/rand"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	_ "net/http/pprof"
@@ -16,35 +18,65 @@ import (
 	"golang.org/x/exp/slog"
 
 	oapi_middleware "github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	server_grpc "github.com/mwasilew2/go-service-template/gen/server-grpc"
 	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+	"github.com/mwasilew2/go-service-template/internal/adapters/favouritesdb"
+	"github.com/mwasilew2/go-service-template/internal/adapters/namedaysdb"
 	"github.com/mwasilew2/go-service-template/internal/adapters/namesdb"
+	"github.com/mwasilew2/go-service-template/internal/adapters/sessionsdb"
+	"github.com/mwasilew2/go-service-template/internal/adapters/sqlitedb"
+	"github.com/mwasilew2/go-service-template/internal/adapters/tournamentsdb"
+	"github.com/mwasilew2/go-service-template/internal/adapters/variantsdb"
+	"github.com/mwasilew2/go-service-template/internal/domain/compatibility"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/namedays"
 	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+	"github.com/mwasilew2/go-service-template/internal/domain/sessions"
+	"github.com/mwasilew2/go-service-template/internal/domain/tournaments"
+	"github.com/mwasilew2/go-service-template/internal/domain/trends"
 	"github.com/oklog/run"
 	slogecho "github.com/samber/slog-echo"
 	"google.golang.org/grpc"
 )
 
-var ErrIncorrectYearParameter = errors.New("invalid year parameter")
-
 type serverCmd struct {
 	// cli options
-	HttpAddr  string `help:"address which the http server should listen on" default:":8080" env:"HTTP_ADDR"`
-	HttpDebug bool   `help:"enable debug messages in the http server responses" default:"false" env:"HTTP_DEBUG"`
-	GrpcAddr  string `help:"address which the grpc server should listen on" default:":8081" env:"GRPC_ADDR"`
+	HttpAddr           string        `help:"address which the http server should listen on" default:":8080" env:"HTTP_ADDR"`
+	HttpDebug          bool          `help:"enable debug messages in the http server responses" default:"false" env:"HTTP_DEBUG"`
+	GrpcAddr           string        `help:"address which the grpc server should listen on" default:":8081" env:"GRPC_ADDR"`
+	Storage            string        `help:"where names are served from, memory loads datasets on start, sqlite serves a database filled by the import command" enum:"memory,sqlite" default:"memory" env:"STORAGE"`
+	DataDir            string        `help:"directory with per-year name datasets (*.csv) and optionally metadata of names (metadata.json), the embedded ones are used if not set, only used with memory storage" type:"existingdir" env:"DATA_DIR"`
+	Database           string        `help:"SQLite database filled by the import command, only used with sqlite storage" type:"path" default:"./names.db" env:"DATABASE"`
+	DataReloadInterval time.Duration `help:"how often the data directory should be checked for changed datasets" default:"30s" env:"DATA_RELOAD_INTERVAL"`
+	CursorSecret       string        `help:"secret used to sign pagination cursors, a random one is generated if not set, so cursors don't survive restarts" env:"CURSOR_SECRET"`
+	StateDir           string        `help:"directory where shortlists, sessions and tournaments are stored, created if it doesn't exist" type:"path" default:"./state" env:"STATE_DIR"`
+	NameDaysFile       string        `help:"CSV file with a name-day calendar (date,name), the embedded calendar is used if not set" type:"existingfile" env:"NAMEDAYS_FILE"`
+	VariantsFile       string        `help:"CSV file with variants of names (name,variant,kind,language), the embedded dataset is used if not set" type:"existingfile" env:"VARIANTS_FILE"`
 
 	// Dependencies
-	logger       *slog.Logger
-	namesService ports.NamesService
+	logger        *slog.Logger
+	namesDB       *namesdb.ReloadableNamesDB // nil unless names are served from memory
+	namesService  ports.NamesService
+	trendsService *trends.Service
+	cursors       *cursorCodec
+
+	compatibilityService *compatibility.Service
+	nameDaysService      *namedays.Service
+	variantsService      ports.VariantsService
+
+	favouritesService  ports.FavouritesService
+	sessionsService    *sessions.Service
+	tournamentsService *tournaments.Service
 
 	// Embedded types
 	server_grpc.UnimplementedAppServerServer
 }
 
-func (c *serverCmd) parseYear(year *int64) (int64, error) {
+func (c *serverCmd) parseYear(ctx context.Context, year *int64) (int64, error) {
 	var parsedYear int64
 	if year != nil {
 		if *year < 0 {
@@ -56,9 +88,12 @@ func (c *serverCmd) parseYear(year *int64) (int64, error) {
 	} else {
 		parsedYear = int64(time.Now().Year())
 	}
-	_, err := c.namesService.GetName(context.Background(), parsedYear, 0)
+	years, err := c.namesService.GetYearsAvailable(ctx)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("failed to get years available: %w", err)
+	}
+	if _, ok := years[parsedYear]; !ok {
+		return 0, ports.ErrYearNotFound
 	}
 	return parsedYear, nil
 
@@ -68,7 +103,7 @@ func parseLimit(limit *int64) (int64, error) {
 	var result int64
 	if limit != nil {
 		if *limit < 0 {
-			return 0, fmt.Errorf("incorrect request parameters, limit must be >= 0")
+			return 0, ErrIncorrectLimitParameter
 		}
 		if *limit != 0 {
 			result = *limit
@@ -84,7 +119,7 @@ func parsePage(page *int64) (int64, error) {
 	var result int64
 	if page != nil {
 		if *page < 0 {
-			return 0, fmt.Errorf("incorrect request parameters, page must be >= 0")
+			return 0, ErrIncorrectPageParameter
 		}
 		if *page != 0 {
 			result = *page
@@ -93,110 +128,303 @@ func parsePage(page *int64) (int64, error) {
 	return result, nil
 }
 
+// getPage returns a page of names, narrowed down to a single gender if one is given
+func (c *serverCmd) getPage(ctx context.Context, year int64, gender *models.Gender, page int64, limit int64) ([]*models.Name, error) {
+	if gender == nil {
+		return c.namesService.GetPage(ctx, year, page, limit)
+	}
+	return c.namesService.GetPageByGender(ctx, year, *gender, page, limit)
+}
+
+// getNoOfEntries returns the number of names, narrowed down to a single gender if one is given
+func (c *serverCmd) getNoOfEntries(ctx context.Context, year int64, gender *models.Gender) (int64, error) {
+	if gender == nil {
+		return c.namesService.GetNoOfEntries(ctx, year)
+	}
+	return c.namesService.GetNoOfEntriesByGender(ctx, year, *gender)
+}
+
 func (c *serverCmd) GetV1Name(ctx context.Context, request server_oapi.GetV1NameRequestObject) (server_oapi.GetV1NameResponseObject, error) {
 	c.logger.Debug("request", "request", request)
 
-	// year
-	year, err := c.parseYear(request.Params.Year)
-	if err != nil {
-		switch {
-		case errors.Is(err, namesdb.ErrYearNotFound):
-			return server_oapi.GetV1NamedefaultJSONResponse{
-				Body: server_oapi.Error{
-					Code:    http.StatusBadRequest,
-					Message: "year not available",
-				},
-				StatusCode: http.StatusBadRequest,
-			}, nil
-		case errors.Is(err, ErrIncorrectYearParameter):
-			return server_oapi.GetV1NamedefaultJSONResponse{
-				Body: server_oapi.Error{
-					Code:    http.StatusBadRequest,
-					Message: "invalid year parameter",
-				},
-				StatusCode: http.StatusBadRequest,
-			}, nil
-		default:
-			return nil, fmt.Errorf("failed to parse year: %w", err)
+	query, filtered := toNameQuery(request.Params)
+	if request.Params.Cursor != nil {
+		if filtered {
+			return nil, ErrCursorWithFilters
 		}
+		return c.getV1NameByCursor(ctx, request)
 	}
-	years, err := c.namesService.GetYearsAvailable(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get years available: %w", err)
-	}
-	if _, ok := years[year]; !ok {
-		return nil, fmt.Errorf("year %d not available", year)
+	if filtered {
+		return c.getV1NameByQuery(ctx, request, query)
 	}
 
+	// year
+	year, err := c.parseYear(ctx, request.Params.Year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
+	}
 	// limit
 	limit, err := parseLimit(request.Params.Limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse limit: %w", err)
 	}
 
+	// gender
+	var gender *models.Gender
+	if request.Params.Gender != nil {
+		g := models.Gender(*request.Params.Gender)
+		gender = &g
+	}
+
 	// page
 	page, err := parsePage(request.Params.Page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse page: %w", err)
 	}
-	count, err := c.namesService.GetNoOfEntries(ctx, year)
+	count, err := c.getNoOfEntries(ctx, year, gender)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get no of entries: %w", err)
 	}
 	if page*limit > count {
-		return nil, fmt.Errorf("incorrect request parameters, page*limit must be <= count")
+		return nil, ErrPageOutOfRange
+	}
+
+	// get data from DB
+	result, err := c.getPage(ctx, year, gender, page, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page: %w", err)
+	}
+	total, err := c.getNoOfEntries(ctx, year, gender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get no of entries: %w", err)
+	}
+
+	// cursors, so that clients can switch to cursor based pagination
+	var cursorGender models.Gender
+	if gender != nil {
+		cursorGender = *gender
+	}
+	next, prev, err := c.pageCursors(ctx, year, cursorGender, limit, result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cursors: %w", err)
+	}
+
+	// convert to output type
+	output := []server_oapi.NameEntry{}
+	for _, entry := range result {
+		output = append(output, toNameEntry(entry))
+	}
+	return server_oapi.GetV1Name200JSONResponse{
+		Limit: limit,
+		Names: output,
+		Page:  &page,
+		Next:  next,
+		Prev:  prev,
+		Total: total,
+		Year:  year,
+	}, nil
+}
+
+// toNameQuery converts filters and the sort order of names, it tells if names should be filtered or sorted
+// differently than by default
+func toNameQuery(params server_oapi.GetV1NameParams) (models.NameQuery, bool) {
+	query := models.NameQuery{
+		SortBy: models.SortById,
+	}
+	var filtered bool
+	if params.Gender != nil {
+		query.Gender = models.Gender(*params.Gender)
+	}
+	if params.MinLength != nil {
+		query.MinLength = *params.MinLength
+		filtered = true
+	}
+	if params.MaxLength != nil {
+		query.MaxLength = *params.MaxLength
+		filtered = true
+	}
+	if params.MinSyllables != nil {
+		query.MinSyllables = *params.MinSyllables
+		filtered = true
+	}
+	if params.MaxSyllables != nil {
+		query.MaxSyllables = *params.MaxSyllables
+		filtered = true
+	}
+	if params.Initial != nil && *params.Initial != "" {
+		query.Initial = *params.Initial
+		filtered = true
+	}
+	if params.Ending != nil && *params.Ending != "" {
+		query.Ending = *params.Ending
+		filtered = true
+	}
+	if params.Origin != nil && *params.Origin != "" {
+		query.Origin = *params.Origin
+		filtered = true
+	}
+	// the request validator fills in defaults, so only values other than the defaults change the order
+	if params.Sort != nil && models.NameSortKey(*params.Sort) != models.SortById {
+		query.SortBy = models.NameSortKey(*params.Sort)
+		filtered = true
+	}
+	if params.Order != nil && *params.Order == server_oapi.Desc {
+		query.Descending = true
+		filtered = true
+	}
+	return query, filtered
+}
+
+// getV1NameByQuery returns a page of filtered or sorted names, there are no cursors for such pages, because they
+// aren't ordered by id
+func (c *serverCmd) getV1NameByQuery(ctx context.Context, request server_oapi.GetV1NameRequestObject, query models.NameQuery) (server_oapi.GetV1NameResponseObject, error) {
+	// year
+	year, err := c.parseYear(ctx, request.Params.Year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
+	}
+	// limit
+	limit, err := parseLimit(request.Params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse limit: %w", err)
+	}
+	// page
+	page, err := parsePage(request.Params.Page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page: %w", err)
 	}
 
 	// get data from DB
-	result, err := c.namesService.GetPage(ctx, year, page, limit)
+	result, total, err := c.namesService.GetPageByQuery(ctx, year, query, page, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get page: %w", err)
 	}
+	if page*limit > total {
+		return nil, ErrPageOutOfRange
+	}
+
+	// convert to output type
+	output := []server_oapi.NameEntry{}
+	for _, entry := range result {
+		output = append(output, toNameEntry(entry))
+	}
+	return server_oapi.GetV1Name200JSONResponse{
+		Limit: limit,
+		Names: output,
+		Page:  &page,
+		Total: total,
+		Year:  year,
+	}, nil
+}
+
+// getV1NameByCursor returns names next to the position a cursor points at
+func (c *serverCmd) getV1NameByCursor(ctx context.Context, request server_oapi.GetV1NameRequestObject) (server_oapi.GetV1NameResponseObject, error) {
+	cursor, err := c.cursors.decode(*request.Params.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+	if request.Params.Page != nil {
+		return nil, ErrCursorWithPage
+	}
+	if request.Params.Year != nil && *request.Params.Year != cursor.Year {
+		return nil, ErrCursorMismatch
+	}
+	if request.Params.Gender != nil && models.Gender(*request.Params.Gender) != cursor.Gender {
+		return nil, ErrCursorMismatch
+	}
+
+	// year, the dataset could have been reloaded since the cursor was issued
+	year, err := c.parseYear(ctx, &cursor.Year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
+	}
+
+	// limit
+	limit := cursor.Limit
+	if request.Params.Limit != nil {
+		limit, err = parseLimit(request.Params.Limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse limit: %w", err)
+		}
+	}
+
+	// get data from DB
+	var result []*models.Name
+	if cursor.Before {
+		result, err = c.namesService.GetNamesBefore(ctx, year, cursor.Gender, cursor.Id, limit)
+	} else {
+		result, err = c.namesService.GetNamesAfter(ctx, year, cursor.Gender, cursor.Id, limit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get names: %w", err)
+	}
 	total, err := c.namesService.GetNoOfEntries(ctx, year)
+	if cursor.Gender != "" {
+		total, err = c.namesService.GetNoOfEntriesByGender(ctx, year, cursor.Gender)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get no of entries: %w", err)
 	}
+	next, prev, err := c.pageCursors(ctx, year, cursor.Gender, limit, result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cursors: %w", err)
+	}
 
 	// convert to output type
-	var output []server_oapi.NameEntry
+	output := []server_oapi.NameEntry{}
 	for _, entry := range result {
-		output = append(output, server_oapi.NameEntry{
-			Name: &entry.Value,
-		})
+		output = append(output, toNameEntry(entry))
 	}
 	return server_oapi.GetV1Name200JSONResponse{
 		Limit: limit,
 		Names: output,
-		Page:  page,
+		Next:  next,
+		Prev:  prev,
 		Total: total,
 		Year:  year,
 	}, nil
 }
 
+// pageCursors returns cursors pointing at names following and preceding a page, a cursor is nil if there are no such
+// names
+func (c *serverCmd) pageCursors(ctx context.Context, year int64, gender models.Gender, limit int64, page []*models.Name) (next *string, prev *string, err error) {
+	if len(page) == 0 {
+		return nil, nil, nil
+	}
+	first, last := page[0], page[len(page)-1]
+
+	following, err := c.namesService.GetNamesAfter(ctx, year, gender, last.Id, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(following) > 0 {
+		token, err := c.cursors.encode(nameCursor{Year: year, Gender: gender, Id: last.Id, Limit: limit})
+		if err != nil {
+			return nil, nil, err
+		}
+		next = &token
+	}
+
+	preceding, err := c.namesService.GetNamesBefore(ctx, year, gender, first.Id, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(preceding) > 0 {
+		token, err := c.cursors.encode(nameCursor{Year: year, Gender: gender, Id: first.Id, Before: true, Limit: limit})
+		if err != nil {
+			return nil, nil, err
+		}
+		prev = &token
+	}
+
+	return next, prev, nil
+}
+
 func (c *serverCmd) GetV1NameId(ctx context.Context, request server_oapi.GetV1NameIdRequestObject) (server_oapi.GetV1NameIdResponseObject, error) {
 	// year
-	year, err := c.parseYear(request.Params.Year)
-	if err != nil {
-		switch {
-		case errors.Is(err, namesdb.ErrYearNotFound):
-			return server_oapi.GetV1NameIddefaultJSONResponse{
-				Body: server_oapi.Error{
-					Code:    http.StatusBadRequest,
-					Message: "year not available",
-				},
-				StatusCode: http.StatusBadRequest,
-			}, nil
-		case errors.Is(err, ErrIncorrectYearParameter):
-			return server_oapi.GetV1NameIddefaultJSONResponse{
-				Body: server_oapi.Error{
-					Code:    http.StatusBadRequest,
-					Message: "invalid year parameter",
-				},
-				StatusCode: http.StatusBadRequest,
-			}, nil
-		default:
-			return nil, fmt.Errorf("failed to parse year: %w", err)
-		}
+	year, err := c.parseYear(ctx, request.Params.Year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
 	}
 
 	// get name
@@ -204,20 +432,305 @@ func (c *serverCmd) GetV1NameId(ctx context.Context, request server_oapi.GetV1Na
 	if err != nil {
 		return nil, fmt.Errorf("failed to get name: %w", err)
 	}
-	return server_oapi.GetV1NameId200JSONResponse{
-		Name: &(*nameEntry).Value,
+	return server_oapi.GetV1NameId200JSONResponse(toNameEntry(nameEntry)), nil
+}
+
+func (c *serverCmd) GetV1NameSearch(ctx context.Context, request server_oapi.GetV1NameSearchRequestObject) (server_oapi.GetV1NameSearchResponseObject, error) {
+	// year
+	year, err := c.parseYear(ctx, request.Params.Year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
+	}
+
+	// limit
+	limit, err := parseLimit(request.Params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse limit: %w", err)
+	}
+
+	// search
+	result, err := c.namesService.Search(ctx, year, request.Params.Q, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search names: %w", err)
+	}
+
+	// convert to output type
+	output := []server_oapi.NameEntry{}
+	for _, entry := range result {
+		output = append(output, toNameEntry(entry))
+	}
+	response := server_oapi.GetV1NameSearch200JSONResponse{
+		Names: output,
+		Query: request.Params.Q,
+		Year:  year,
+	}
+
+	// variants
+	if request.Params.Variants != nil && *request.Params.Variants {
+		variants, err := c.searchVariants(ctx, year, result, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get variants: %w", err)
+		}
+		response.Variants = &variants
+	}
+	return response, nil
+}
+
+// searchVariants returns up to limit entries of variants of names found by a search, skipping the names themselves
+func (c *serverCmd) searchVariants(ctx context.Context, year int64, names []*models.Name, limit int64) ([]server_oapi.NameEntry, error) {
+	seen := make(map[int64]struct{})
+	for _, name := range names {
+		seen[name.Id] = struct{}{}
+	}
+	output := []server_oapi.NameEntry{}
+	for _, name := range names {
+		variants, err := c.variantsService.GetVariants(ctx, year, name.Value)
+		if err != nil {
+			return nil, err
+		}
+		for _, variant := range variants {
+			for _, entry := range variant.Entries {
+				if _, ok := seen[entry.Id]; ok {
+					continue
+				}
+				if int64(len(output)) >= limit {
+					return output, nil
+				}
+				seen[entry.Id] = struct{}{}
+				output = append(output, toNameEntry(entry))
+			}
+		}
+	}
+	return output, nil
+}
+
+// maxRandomCount is the maximum number of random names returned at once
+const maxRandomCount = 100
+
+func (c *serverCmd) GetV1NameRandom(ctx context.Context, request server_oapi.GetV1NameRandomRequestObject) (server_oapi.GetV1NameRandomResponseObject, error) {
+	// year
+	year, err := c.parseYear(ctx, request.Params.Year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
+	}
+
+	// count
+	count := int64(1)
+	if request.Params.Count != nil {
+		count = *request.Params.Count
+	}
+	if count < 0 || count > maxRandomCount {
+		return nil, ErrIncorrectCountParameter
+	}
+
+	// gender
+	var gender models.Gender
+	if request.Params.Gender != nil {
+		gender = models.Gender(*request.Params.Gender)
+	}
+
+	// seed, a random one is returned to clients, so that they can repeat the request
+	var seed int64
+	if request.Params.Seed != nil {
+		seed = *request.Params.Seed
+	} else {
+		seed = mathrand.Int63()
+	}
+
+	// excluded names
+	exclude := make(map[int64]struct{})
+	if request.Params.Exclude != nil {
+		for _, id := range *request.Params.Exclude {
+			exclude[id] = struct{}{}
+		}
+	}
+
+	// get data from DB
+	weighted := request.Params.Weighted != nil && *request.Params.Weighted
+	result, err := c.namesService.Random(ctx, year, gender, count, weighted, &seed, exclude)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get random names: %w", err)
+	}
+
+	// convert to output type
+	output := []server_oapi.NameEntry{}
+	for _, entry := range result {
+		output = append(output, toNameEntry(entry))
+	}
+	return server_oapi.GetV1NameRandom200JSONResponse{
+		Names: output,
+		Year:  year,
+		Seed:  seed,
 	}, nil
 }
 
+func (c *serverCmd) GetV1NameByValueName(ctx context.Context, request server_oapi.GetV1NameByValueNameRequestObject) (server_oapi.GetV1NameByValueNameResponseObject, error) {
+	history, err := c.namesService.GetNameHistory(ctx, request.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get name history: %w", err)
+	}
+
+	// convert to output type
+	name := request.Name
+	output := []server_oapi.NameHistoryEntry{}
+	for _, entry := range history {
+		historyEntry := server_oapi.NameHistoryEntry{
+			Year: entry.Year,
+		}
+		if entry.Name != nil {
+			name = entry.Name.Value
+			gender := server_oapi.Gender(entry.Name.Gender)
+			historyEntry.Count = entry.Name.Count
+			historyEntry.Rank = &entry.Name.Rank
+			historyEntry.Gender = &gender
+			historyEntry.Id = &entry.Name.Id
+		}
+		output = append(output, historyEntry)
+	}
+	return server_oapi.GetV1NameByValueName200JSONResponse{
+		Name:    name,
+		History: output,
+	}, nil
+}
+
+func (c *serverCmd) GetV1Trends(ctx context.Context, request server_oapi.GetV1TrendsRequestObject) (server_oapi.GetV1TrendsResponseObject, error) {
+	// limit
+	limit, err := parseLimit(request.Params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse limit: %w", err)
+	}
+
+	// metric
+	metric := trends.ByRank
+	if request.Params.By != nil {
+		metric = trends.Metric(*request.Params.By)
+	}
+
+	// get report
+	report, err := c.trendsService.Report(ctx, request.Params.From, request.Params.To, metric, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trends report: %w", err)
+	}
+
+	return server_oapi.GetV1Trends200JSONResponse{
+		From:        report.From,
+		To:          report.To,
+		By:          string(metric),
+		Risers:      toTrendEntries(report.Risers),
+		Fallers:     toTrendEntries(report.Fallers),
+		NewEntrants: toTrendEntries(report.NewEntrants),
+		Dropouts:    toTrendEntries(report.Dropouts),
+	}, nil
+}
+
+func toTrendEntries(list []*models.Trend) []server_oapi.TrendEntry {
+	output := []server_oapi.TrendEntry{}
+	for _, trend := range list {
+		entry := server_oapi.TrendEntry{
+			Name:   trend.Value,
+			Gender: server_oapi.Gender(trend.Gender),
+		}
+		if trend.From != nil {
+			entry.FromRank = &trend.From.Rank
+			entry.FromCount = &trend.From.Count
+		}
+		if trend.To != nil {
+			entry.ToRank = &trend.To.Rank
+			entry.ToCount = &trend.To.Count
+		}
+		if trend.From != nil && trend.To != nil {
+			rankChange, shareChange := trend.RankChange, trend.ShareChange
+			entry.RankChange = &rankChange
+			entry.ShareChange = &shareChange
+		}
+		output = append(output, entry)
+	}
+	return output
+}
+
+func toNameEntry(name *models.Name) server_oapi.NameEntry {
+	gender := server_oapi.Gender(name.Gender)
+	entry := server_oapi.NameEntry{
+		Id:     &name.Id,
+		Name:   &name.Value,
+		Gender: &gender,
+		Count:  &name.Count,
+		Rank:   &name.Rank,
+		Traits: &server_oapi.NameTraits{
+			Length:    name.Traits.Length,
+			Syllables: name.Traits.Syllables,
+			Initial:   name.Traits.Initial,
+			Ending:    name.Traits.Ending,
+			Pattern:   name.Traits.Pattern,
+		},
+	}
+	if name.Meta != nil {
+		entry.Meta = &server_oapi.NameMeta{
+			Origin:    name.Meta.Origin,
+			Etymology: name.Meta.Etymology,
+			Meaning:   name.Meta.Meaning,
+		}
+	}
+	return entry
+}
+
 func (c *serverCmd) Run(cmdCtx *cmdContext) error {
 	c.logger = cmdCtx.Logger.With("component", "serverCmd")
 
 	// initialize dependencies
 	var err error
-	c.namesService, err = namesdb.NewNamesDB()
+	switch c.Storage {
+	case "sqlite":
+		// opening a database which doesn't exist would create an empty one
+		if _, err := os.Stat(c.Database); err != nil {
+			return fmt.Errorf("failed to find names database, run the import command first: %w", err)
+		}
+		namesStore, err := sqlitedb.NewSQLiteNamesDB(c.Database)
+		if err != nil {
+			return fmt.Errorf("failed to initialize names service: %w", err)
+		}
+		defer namesStore.Close()
+		c.namesService = namesStore
+	default:
+		c.namesDB, err = namesdb.NewReloadableNamesDB(c.DataDir)
+		if err != nil {
+			return fmt.Errorf("failed to initialize names service: %w", err)
+		}
+		c.namesService = c.namesDB
+	}
+	c.trendsService = trends.NewService(c.namesService)
+	c.compatibilityService = compatibility.NewService(c.namesService)
+	nameDaysDB, err := namedaysdb.NewNameDaysDB(c.NameDaysFile)
+	if err != nil {
+		return fmt.Errorf("failed to initialize name days: %w", err)
+	}
+	c.nameDaysService = namedays.NewService(nameDaysDB, c.namesService)
+	c.variantsService, err = variantsdb.NewVariantsDB(c.VariantsFile, c.namesService)
+	if err != nil {
+		return fmt.Errorf("failed to initialize variants service: %w", err)
+	}
+	cursorKey := []byte(c.CursorSecret)
+	if len(cursorKey) == 0 {
+		cursorKey = make([]byte, 32)
+		if _, err := rand.Read(cursorKey); err != nil {
+			return fmt.Errorf("failed to generate cursor secret: %w", err)
+		}
+	}
+	c.cursors = newCursorCodec(cursorKey)
+	c.favouritesService, err = favouritesdb.NewFavouritesDB(c.StateDir)
+	if err != nil {
+		return fmt.Errorf("failed to initialize favourites service: %w", err)
+	}
+	sessionsDB, err := sessionsdb.NewSessionsDB(c.StateDir)
 	if err != nil {
-		return fmt.Errorf("failed to initialize names service: %w", err)
+		return fmt.Errorf("failed to initialize sessions store: %w", err)
 	}
+	c.sessionsService = sessions.NewService(sessionsDB, c.namesService)
+	tournamentsDB, err := tournamentsdb.NewTournamentsDB(c.StateDir)
+	if err != nil {
+		return fmt.Errorf("failed to initialize tournaments store: %w", err)
+	}
+	c.tournamentsService = tournaments.NewService(tournamentsDB, c.favouritesService)
 
 	// create a run group
 	g := run.Group{}
@@ -235,6 +748,14 @@ func (c *serverCmd) Run(cmdCtx *cmdContext) error {
 	e.Use(slogEchoMiddleware)
 	e.Use(echoprometheus.NewMiddleware("echo"))
 	e.Use(middleware.Recover())
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		// serve the whole request from a single dataset, even if it's reloaded in the meantime
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			ctx.SetRequest(req.WithContext(c.withSnapshot(req.Context())))
+			return next(ctx)
+		}
+	})
 
 	// http routes
 	// admin routes
@@ -254,8 +775,14 @@ func (c *serverCmd) Run(cmdCtx *cmdContext) error {
 			}
 			return false
 		},
+		Options: openapi3filter.Options{
+			// report all invalid parameters at once
+			MultiError: true,
+		},
+		ErrorHandler:      handleValidationError,
+		MultiErrorHandler: validationMultiErrorHandler,
 	}))
-	strictSrv := server_oapi.NewStrictHandler(c, nil)
+	strictSrv := server_oapi.NewStrictHandler(c, []server_oapi.StrictMiddlewareFunc{c.translateErrors})
 	server_oapi.RegisterHandlersWithBaseURL(e, strictSrv, "/api")
 
 	// static files
@@ -272,6 +799,8 @@ func (c *serverCmd) Run(cmdCtx *cmdContext) error {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 		c.logger.Debug("shutting down http server")
+		// end streams of matches, otherwise they would keep the server from shutting down
+		c.sessionsService.Close()
 		if err := e.Shutdown(ctx); err != nil {
 			c.logger.Error("failed to shutdown http server", "error", err)
 			return
@@ -285,7 +814,15 @@ func (c *serverCmd) Run(cmdCtx *cmdContext) error {
 	if err != nil {
 		return fmt.Errorf("tcp failed to listen on: %w", err)
 	}
-	srv = grpc.NewServer()
+	srv = grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			// serve the whole request from a single dataset, even if it's reloaded in the meantime
+			return handler(c.withSnapshot(ctx), req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, &snapshotServerStream{ServerStream: ss, ctx: c.withSnapshot(ss.Context())})
+		}),
+	)
 	server_grpc.RegisterAppServerServer(srv, c)
 
 	// start grpc server
@@ -298,6 +835,44 @@ func (c *serverCmd) Run(cmdCtx *cmdContext) error {
 		c.logger.Debug("grpc server stopped")
 	})
 
+	// reload datasets when they change in the data directory or when SIGHUP is received
+	if c.namesDB != nil && c.DataDir != "" {
+		hupSigChan := make(chan os.Signal, 1)
+		signal.Notify(hupSigChan, syscall.SIGHUP)
+		ticker := time.NewTicker(c.DataReloadInterval)
+		reloadDone := make(chan struct{})
+		g.Add(func() error {
+			c.logger.Info("watching data directory", "directory", c.DataDir, "interval", c.DataReloadInterval)
+			for {
+				select {
+				case <-hupSigChan:
+					c.logger.Info("caught SIGHUP, reloading datasets")
+					if err := c.namesDB.Reload(); err != nil {
+						c.logger.Error("failed to reload datasets, previous data is still served", "error", err)
+						continue
+					}
+					c.logger.Info("datasets reloaded")
+				case <-ticker.C:
+					reloaded, err := c.namesDB.ReloadIfChanged()
+					if err != nil {
+						c.logger.Error("failed to reload datasets, previous data is still served", "error", err)
+						continue
+					}
+					if reloaded {
+						c.logger.Info("datasets changed, reloaded")
+					}
+				case <-reloadDone:
+					c.logger.Debug("data directory watching goroutine stopped")
+					return nil
+				}
+			}
+		}, func(err error) {
+			signal.Stop(hupSigChan)
+			ticker.Stop()
+			close(reloadDone)
+		})
+	}
+
 	// listen for termination signals
 	osSigChan := make(chan os.Signal, 1)
 	signal.Notify(osSigChan, os.Kill, os.Interrupt)
@@ -318,9 +893,20 @@ func (c *serverCmd) Run(cmdCtx *cmdContext) error {
 	return g.Run()
 }
 
-func (c *serverCmd) Send(ctx context.Context, req *server_grpc.SendRequest) (*server_grpc.SendResponse, error) {
-	c.logger.Debug("received Send request", "req", req)
-	return &server_grpc.SendResponse{
-		Status: 200,
-	}, nil
+// withSnapshot pins the current dataset to a context, names served from a database don't need it
+func (c *serverCmd) withSnapshot(ctx context.Context) context.Context {
+	if c.namesDB == nil {
+		return ctx
+	}
+	return c.namesDB.WithSnapshot(ctx)
+}
+
+// snapshotServerStream overrides the context of a grpc stream with one which has a dataset pinned to it
+type snapshotServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *snapshotServerStream) Context() context.Context {
+	return s.ctx
 }
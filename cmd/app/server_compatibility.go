@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+func (c *serverCmd) PostV1Compatibility(ctx context.Context, request server_oapi.PostV1CompatibilityRequestObject) (server_oapi.PostV1CompatibilityResponseObject, error) {
+	results, err := c.compatibilityService.Check(ctx, request.Body.Surname, request.Body.Names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check compatibility: %w", err)
+	}
+	c.logger.Debug("compatibility checked", "surname", request.Body.Surname, "names", len(request.Body.Names))
+
+	output := server_oapi.PostV1Compatibility200JSONResponse{
+		Surname: request.Body.Surname,
+		Results: []server_oapi.CompatibilityEntry{},
+	}
+	for _, result := range results {
+		output.Surname = result.Surname
+		output.Results = append(output.Results, toCompatibilityEntry(result))
+	}
+	return output, nil
+}
+
+func toCompatibilityEntry(compatibility *models.Compatibility) server_oapi.CompatibilityEntry {
+	entry := server_oapi.CompatibilityEntry{
+		Name:  compatibility.Value,
+		Known: compatibility.Name != nil,
+		Traits: server_oapi.NameTraits{
+			Length:    compatibility.Traits.Length,
+			Syllables: compatibility.Traits.Syllables,
+			Initial:   compatibility.Traits.Initial,
+			Ending:    compatibility.Traits.Ending,
+			Pattern:   compatibility.Traits.Pattern,
+		},
+		Initials:        compatibility.Initials,
+		AwkwardInitials: compatibility.AwkwardInitials,
+		BoundaryRepeat:  compatibility.BoundaryRepeat,
+		VowelClash:      compatibility.VowelClash,
+		Syllables:       compatibility.Syllables,
+		Rhythm:          compatibility.Rhythm,
+		Warnings:        []string{},
+	}
+	if compatibility.Name != nil {
+		entry.Gender = toOptionalGender(compatibility.Name.Gender)
+	}
+	entry.Warnings = append(entry.Warnings, compatibility.Warnings...)
+	return entry
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"sort"
+
+	server_grpc "github.com/mwasilew2/go-service-template/gen/server-grpc"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"google.golang.org/grpc/status"
+)
+
+func (c *serverCmd) Send(ctx context.Context, req *server_grpc.SendRequest) (*server_grpc.SendResponse, error) {
+	c.logger.Debug("received Send request", "req", req)
+	return &server_grpc.SendResponse{
+		Status: 200,
+	}, nil
+}
+
+func (c *serverCmd) GetName(ctx context.Context, req *server_grpc.GetNameRequest) (*server_grpc.GetNameResponse, error) {
+	c.logger.Debug("received GetName request", "req", req)
+
+	// year
+	year, err := c.parseYear(ctx, req.Year)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	// get name
+	name, err := c.namesService.GetName(ctx, year, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &server_grpc.GetNameResponse{
+		Year: year,
+		Name: toGrpcName(name),
+	}, nil
+}
+
+func (c *serverCmd) ListNames(ctx context.Context, req *server_grpc.ListNamesRequest) (*server_grpc.ListNamesResponse, error) {
+	c.logger.Debug("received ListNames request", "req", req)
+
+	// year
+	year, err := c.parseYear(ctx, req.Year)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	// limit
+	limit, err := parseLimit(&req.Limit)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	// page
+	page, err := parsePage(&req.Page)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	gender := fromGrpcGender(req.Gender)
+	count, err := c.getNoOfEntries(ctx, year, gender)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	if page*limit > count {
+		return nil, toGrpcError(ErrPageOutOfRange)
+	}
+
+	// get data from DB
+	result, err := c.getPage(ctx, year, gender, page, limit)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	// convert to output type
+	names := make([]*server_grpc.Name, 0, len(result))
+	for _, name := range result {
+		names = append(names, toGrpcName(name))
+	}
+	return &server_grpc.ListNamesResponse{
+		Names: names,
+		Year:  year,
+		Page:  page,
+		Limit: limit,
+		Total: count,
+	}, nil
+}
+
+func (c *serverCmd) ListYears(ctx context.Context, req *server_grpc.ListYearsRequest) (*server_grpc.ListYearsResponse, error) {
+	c.logger.Debug("received ListYears request", "req", req)
+
+	available, err := c.namesService.GetYearsAvailable(ctx)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	years := make([]int64, 0, len(available))
+	for year := range available {
+		years = append(years, year)
+	}
+	sort.Slice(years, func(i, j int) bool { return years[i] < years[j] })
+	return &server_grpc.ListYearsResponse{
+		Years: years,
+	}, nil
+}
+
+func (c *serverCmd) CountNames(ctx context.Context, req *server_grpc.CountNamesRequest) (*server_grpc.CountNamesResponse, error) {
+	c.logger.Debug("received CountNames request", "req", req)
+
+	// year
+	year, err := c.parseYear(ctx, req.Year)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	count, err := c.getNoOfEntries(ctx, year, fromGrpcGender(req.Gender))
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &server_grpc.CountNamesResponse{
+		Year:  year,
+		Count: count,
+	}, nil
+}
+
+const (
+	defaultStreamChunkSize = 100
+	maxStreamChunkSize     = 1000
+)
+
+// StreamNames sends all names from a year in chunks. Send blocks while the client isn't keeping up, so the names
+// are read from the database only as fast as the client receives them.
+func (c *serverCmd) StreamNames(req *server_grpc.StreamNamesRequest, stream server_grpc.AppServer_StreamNamesServer) error {
+	ctx := stream.Context()
+	c.logger.Debug("received StreamNames request", "req", req)
+
+	// year
+	year, err := c.parseYear(ctx, req.Year)
+	if err != nil {
+		return toGrpcError(err)
+	}
+
+	// chunk size
+	if req.ChunkSize < 0 || req.ChunkSize > maxStreamChunkSize {
+		return toGrpcError(ErrIncorrectChunkSizeParameter)
+	}
+	chunkSize := req.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+
+	gender := fromGrpcGender(req.Gender)
+	count, err := c.getNoOfEntries(ctx, year, gender)
+	if err != nil {
+		return toGrpcError(err)
+	}
+	for page := int64(0); page*chunkSize < count; page++ {
+		// stop as soon as the client goes away
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		result, err := c.getPage(ctx, year, gender, page, chunkSize)
+		if err != nil {
+			return toGrpcError(err)
+		}
+		names := make([]*server_grpc.Name, 0, len(result))
+		for _, name := range result {
+			names = append(names, toGrpcName(name))
+		}
+		if err := stream.Send(&server_grpc.StreamNamesResponse{
+			Year:  year,
+			Names: names,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toGrpcName(name *models.Name) *server_grpc.Name {
+	return &server_grpc.Name{
+		Id:     name.Id,
+		Name:   name.Value,
+		Gender: toGrpcGender(name.Gender),
+		Count:  name.Count,
+		Rank:   name.Rank,
+	}
+}
+
+func toGrpcGender(gender models.Gender) server_grpc.Gender {
+	switch gender {
+	case models.GenderMale:
+		return server_grpc.Gender_GENDER_MALE
+	case models.GenderFemale:
+		return server_grpc.Gender_GENDER_FEMALE
+	default:
+		return server_grpc.Gender_GENDER_UNSPECIFIED
+	}
+}
+
+// fromGrpcGender returns nil for an unspecified gender
+func fromGrpcGender(gender server_grpc.Gender) *models.Gender {
+	var result models.Gender
+	switch gender {
+	case server_grpc.Gender_GENDER_MALE:
+		result = models.GenderMale
+	case server_grpc.Gender_GENDER_FEMALE:
+		result = models.GenderFemale
+	default:
+		return nil
+	}
+	return &result
+}
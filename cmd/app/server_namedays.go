@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+func (c *serverCmd) GetV1NameIdNamedays(ctx context.Context, request server_oapi.GetV1NameIdNamedaysRequestObject) (server_oapi.GetV1NameIdNamedaysResponseObject, error) {
+	// year
+	year, err := c.parseYear(ctx, request.Params.Year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
+	}
+
+	// get name days
+	name, days, err := c.nameDaysService.ForName(ctx, year, request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get name days: %w", err)
+	}
+
+	// convert to output type
+	dates := []string{}
+	for _, day := range days {
+		dates = append(dates, day.String())
+	}
+	return server_oapi.GetV1NameIdNamedays200JSONResponse{
+		Name:  toNameEntry(name),
+		Dates: dates,
+	}, nil
+}
+
+func (c *serverCmd) GetV1Namedays(ctx context.Context, request server_oapi.GetV1NamedaysRequestObject) (server_oapi.GetV1NamedaysResponseObject, error) {
+	// date
+	now := time.Now()
+	day := models.NameDay{Month: now.Month(), Day: now.Day()}
+	if request.Params.Date != nil {
+		var err error
+		day, err = models.ParseNameDay(*request.Params.Date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse date: %w", err)
+		}
+	}
+
+	// year
+	year, err := c.parseYear(ctx, request.Params.Year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
+	}
+
+	// get names
+	celebrations, err := c.nameDaysService.On(ctx, year, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get names celebrated on %s: %w", day, err)
+	}
+
+	// convert to output type
+	output := []server_oapi.Celebration{}
+	for _, celebration := range celebrations {
+		entries := []server_oapi.NameEntry{}
+		for _, name := range celebration.Entries {
+			entries = append(entries, toNameEntry(name))
+		}
+		output = append(output, server_oapi.Celebration{
+			Name:    celebration.Value,
+			Entries: entries,
+		})
+	}
+	return server_oapi.GetV1Namedays200JSONResponse{
+		Date:  day.String(),
+		Year:  year,
+		Names: output,
+	}, nil
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+// sseKeepAliveInterval is how often a comment is sent to streams without events, so that proxies don't close them
+const sseKeepAliveInterval = 15 * time.Second
+
+func (c *serverCmd) PostV1Sessions(ctx context.Context, request server_oapi.PostV1SessionsRequestObject) (server_oapi.PostV1SessionsResponseObject, error) {
+	var yearParam *int64
+	var gender models.Gender
+	if request.Body != nil {
+		yearParam = request.Body.Year
+		if request.Body.Gender != nil {
+			gender = models.Gender(*request.Body.Gender)
+		}
+	}
+	year, err := c.parseYear(ctx, yearParam)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
+	}
+
+	session, err := c.sessionsService.Create(ctx, year, gender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	c.logger.Debug("session created", "year", year, "gender", gender)
+
+	output := server_oapi.PostV1Sessions201JSONResponse{
+		Id:        session.Id,
+		Year:      session.Year,
+		Gender:    toOptionalGender(session.Gender),
+		CreatedAt: session.CreatedAt,
+		Invites:   []string{},
+	}
+	for _, participant := range session.Participants {
+		output.Invites = append(output.Invites, participant.Token)
+	}
+	return output, nil
+}
+
+func (c *serverCmd) GetV1SessionsId(ctx context.Context, request server_oapi.GetV1SessionsIdRequestObject) (server_oapi.GetV1SessionsIdResponseObject, error) {
+	session, err := c.sessionsService.Get(ctx, request.Id, request.Params.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	return server_oapi.GetV1SessionsId200JSONResponse{
+		Id:        session.Id,
+		Year:      session.Year,
+		Gender:    toOptionalGender(session.Gender),
+		CreatedAt: session.CreatedAt,
+	}, nil
+}
+
+func (c *serverCmd) GetV1SessionsIdNext(ctx context.Context, request server_oapi.GetV1SessionsIdNextRequestObject) (server_oapi.GetV1SessionsIdNextResponseObject, error) {
+	name, err := c.sessionsService.NextName(ctx, request.Id, request.Params.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next name: %w", err)
+	}
+	output := server_oapi.GetV1SessionsIdNext200JSONResponse{}
+	if name != nil {
+		entry := toNameEntry(name)
+		output.Name = &entry
+	}
+	return output, nil
+}
+
+func (c *serverCmd) PostV1SessionsIdVotes(ctx context.Context, request server_oapi.PostV1SessionsIdVotesRequestObject) (server_oapi.PostV1SessionsIdVotesResponseObject, error) {
+	match, err := c.sessionsService.Vote(ctx, request.Id, request.Params.Token, request.Body.Id, request.Body.Like)
+	if err != nil {
+		return nil, fmt.Errorf("failed to vote: %w", err)
+	}
+	output := server_oapi.PostV1SessionsIdVotes200JSONResponse{}
+	if match != nil {
+		entry := toMatch(match)
+		output.Match = &entry
+	}
+	return output, nil
+}
+
+func (c *serverCmd) GetV1SessionsIdMatches(ctx context.Context, request server_oapi.GetV1SessionsIdMatchesRequestObject) (server_oapi.GetV1SessionsIdMatchesResponseObject, error) {
+	matches, err := c.sessionsService.Matches(ctx, request.Id, request.Params.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matches: %w", err)
+	}
+	output := []server_oapi.Match{}
+	for _, match := range matches {
+		output = append(output, toMatch(match))
+	}
+	return server_oapi.GetV1SessionsIdMatches200JSONResponse{
+		Matches: output,
+	}, nil
+}
+
+func (c *serverCmd) GetV1SessionsIdMatchesStream(ctx context.Context, request server_oapi.GetV1SessionsIdMatchesStreamRequestObject) (server_oapi.GetV1SessionsIdMatchesStreamResponseObject, error) {
+	// subscribe before listing matches, so that no match is missed in between
+	newMatches, err := c.sessionsService.Subscribe(ctx, request.Id, request.Params.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to matches: %w", err)
+	}
+	matches, err := c.sessionsService.Matches(ctx, request.Id, request.Params.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matches: %w", err)
+	}
+	return matchesEventStream{
+		matches:    matches,
+		newMatches: newMatches,
+	}, nil
+}
+
+// matchesEventStream sends matches as server-sent events, first the ones made already, then new ones as they're made
+type matchesEventStream struct {
+	matches    []*models.Match
+	newMatches <-chan *models.Match
+}
+
+func (response matchesEventStream) VisitGetV1SessionsIdMatchesStreamResponse(w http.ResponseWriter) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("response writer %T doesn't support streaming", w)
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	sent := make(map[string]struct{})
+	send := func(match *models.Match) error {
+		key := string(match.Gender) + "/" + match.Value
+		if _, ok := sent[key]; ok {
+			return nil
+		}
+		sent[key] = struct{}{}
+		data, err := json.Marshal(toMatch(match))
+		if err != nil {
+			return fmt.Errorf("failed to marshal match: %w", err)
+		}
+		if _, err := fmt.Fprintf(w, "event: match\ndata: %s\n\n", data); err != nil {
+			return fmt.Errorf("failed to send match: %w", err)
+		}
+		flusher.Flush()
+		return nil
+	}
+	for _, match := range response.matches {
+		if err := send(match); err != nil {
+			return err
+		}
+	}
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case match, ok := <-response.newMatches:
+			// closed when the client goes away, falls behind, or the server shuts down
+			if !ok {
+				return nil
+			}
+			if err := send(match); err != nil {
+				return err
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return fmt.Errorf("failed to send keep-alive: %w", err)
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func toMatch(match *models.Match) server_oapi.Match {
+	return server_oapi.Match{
+		Name:      match.Value,
+		Gender:    server_oapi.Gender(match.Gender),
+		MatchedAt: match.MatchedAt,
+	}
+}
+
+func toOptionalGender(gender models.Gender) *server_oapi.Gender {
+	if gender == "" {
+		return nil
+	}
+	output := server_oapi.Gender(gender)
+	return &output
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+func (c *serverCmd) PostV1Shortlists(ctx context.Context, request server_oapi.PostV1ShortlistsRequestObject) (server_oapi.PostV1ShortlistsResponseObject, error) {
+	shortlist, err := c.favouritesService.CreateShortlist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create shortlist: %w", err)
+	}
+	c.logger.Debug("shortlist created")
+	return server_oapi.PostV1Shortlists201JSONResponse(toShortlist(shortlist)), nil
+}
+
+func (c *serverCmd) GetV1ShortlistsId(ctx context.Context, request server_oapi.GetV1ShortlistsIdRequestObject) (server_oapi.GetV1ShortlistsIdResponseObject, error) {
+	shortlist, err := c.favouritesService.GetShortlist(ctx, request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shortlist: %w", err)
+	}
+	return server_oapi.GetV1ShortlistsId200JSONResponse(toShortlist(shortlist)), nil
+}
+
+func (c *serverCmd) PostV1ShortlistsIdNames(ctx context.Context, request server_oapi.PostV1ShortlistsIdNamesRequestObject) (server_oapi.PostV1ShortlistsIdNamesResponseObject, error) {
+	// the shortlist is looked up first, so that a missing shortlist is reported regardless of the name
+	if _, err := c.favouritesService.GetShortlist(ctx, request.Id); err != nil {
+		return nil, fmt.Errorf("failed to get shortlist: %w", err)
+	}
+
+	// only names from the datasets can be shortlisted, they are stored the way they're spelled in the datasets
+	gender := models.Gender(request.Body.Gender)
+	value, err := c.findNameValue(ctx, request.Body.Name, gender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find name: %w", err)
+	}
+
+	shortlist, err := c.favouritesService.AddName(ctx, request.Id, value, gender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add name to shortlist: %w", err)
+	}
+	return server_oapi.PostV1ShortlistsIdNames200JSONResponse(toShortlist(shortlist)), nil
+}
+
+func (c *serverCmd) DeleteV1ShortlistsIdNamesName(ctx context.Context, request server_oapi.DeleteV1ShortlistsIdNamesNameRequestObject) (server_oapi.DeleteV1ShortlistsIdNamesNameResponseObject, error) {
+	var gender models.Gender
+	if request.Params.Gender != nil {
+		gender = models.Gender(*request.Params.Gender)
+	}
+
+	shortlist, err := c.favouritesService.RemoveName(ctx, request.Id, request.Name, gender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove name from shortlist: %w", err)
+	}
+	return server_oapi.DeleteV1ShortlistsIdNamesName200JSONResponse(toShortlist(shortlist)), nil
+}
+
+// findNameValue returns a name the way it's spelled in the datasets, if it was given to a given gender in any of the
+// available years
+func (c *serverCmd) findNameValue(ctx context.Context, value string, gender models.Gender) (string, error) {
+	history, err := c.namesService.GetNameHistory(ctx, value)
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range history {
+		if entry.Name != nil && entry.Name.Gender == gender {
+			return entry.Name.Value, nil
+		}
+	}
+	return "", ports.ErrNameNotFound
+}
+
+func toShortlist(shortlist *models.Shortlist) server_oapi.Shortlist {
+	output := server_oapi.Shortlist{
+		Id:        shortlist.Id,
+		CreatedAt: shortlist.CreatedAt,
+		Names:     []server_oapi.ShortlistEntry{},
+	}
+	for _, entry := range shortlist.Names {
+		output.Names = append(output.Names, server_oapi.ShortlistEntry{
+			Name:    entry.Value,
+			Gender:  server_oapi.Gender(entry.Gender),
+			AddedAt: entry.AddedAt,
+		})
+	}
+	return output
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"golang.org/x/exp/slog"
+
+	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+	"github.com/mwasilew2/go-service-template/internal/adapters/favouritesdb"
+	"github.com/mwasilew2/go-service-template/internal/adapters/namesdb"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+func TestPostV1ShortlistsIdNames(t *testing.T) {
+	namesDB, err := namesdb.NewNamesDB("")
+	if err != nil {
+		t.Fatalf("failed to load embedded dataset: %v", err)
+	}
+	favouritesDB, err := favouritesdb.NewFavouritesDB(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to create favourites: %v", err)
+	}
+	c := &serverCmd{
+		logger:            slog.New(slog.NewTextHandler(io.Discard, nil)),
+		namesService:      namesDB,
+		favouritesService: favouritesDB,
+	}
+	shortlist, err := favouritesDB.CreateShortlist(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create shortlist: %v", err)
+	}
+	male := server_oapi.Gender(models.GenderMale)
+
+	tests := []struct {
+		name        string
+		shortlistId string
+		value       string
+		wantErr     error
+		wantValue   string
+	}{
+		{"missing shortlist, known name", "missing", "jan", ports.ErrShortlistNotFound, ""},
+		{"missing shortlist, unknown name", "missing", "nobody", ports.ErrShortlistNotFound, ""},
+		{"unknown name", shortlist.Id, "nobody", ports.ErrNameNotFound, ""},
+		{"known name is spelled like in the datasets", shortlist.Id, "jan", nil, "JAN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := c.PostV1ShortlistsIdNames(context.Background(), server_oapi.PostV1ShortlistsIdNamesRequestObject{
+				Id:   tt.shortlistId,
+				Body: &server_oapi.PostV1ShortlistsIdNamesJSONRequestBody{Name: tt.value, Gender: male},
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			names := response.(server_oapi.PostV1ShortlistsIdNames200JSONResponse).Names
+			if len(names) != 1 || names[0].Name != tt.wantValue {
+				t.Errorf("expected only %s on the shortlist, got %+v", tt.wantValue, names)
+			}
+		})
+	}
+}
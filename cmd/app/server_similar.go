@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+)
+
+func (c *serverCmd) GetV1NameIdSimilar(ctx context.Context, request server_oapi.GetV1NameIdSimilarRequestObject) (server_oapi.GetV1NameIdSimilarResponseObject, error) {
+	// year
+	year, err := c.parseYear(ctx, request.Params.Year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
+	}
+
+	// limit
+	limit, err := parseLimit(request.Params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse limit: %w", err)
+	}
+
+	// get name
+	name, err := c.namesService.GetName(ctx, year, request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get name: %w", err)
+	}
+
+	// get similar names
+	similar, err := c.namesService.GetSimilar(ctx, year, request.Id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get similar names: %w", err)
+	}
+
+	// convert to output type
+	output := []server_oapi.SimilarName{}
+	for _, entry := range similar {
+		output = append(output, server_oapi.SimilarName{
+			Name:             toNameEntry(entry.Name),
+			PhoneticDistance: entry.PhoneticDistance,
+			EditDistance:     entry.EditDistance,
+		})
+	}
+	return server_oapi.GetV1NameIdSimilar200JSONResponse{
+		Name:    toNameEntry(name),
+		Similar: output,
+	}, nil
+}
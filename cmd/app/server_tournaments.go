@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+func (c *serverCmd) PostV1Tournament(ctx context.Context, request server_oapi.PostV1TournamentRequestObject) (server_oapi.PostV1TournamentResponseObject, error) {
+	tournament, err := c.tournamentsService.Create(ctx, request.Body.ShortlistId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tournament: %w", err)
+	}
+	c.logger.Debug("tournament created", "contenders", len(tournament.Contenders))
+	return server_oapi.PostV1Tournament201JSONResponse(toTournament(tournament)), nil
+}
+
+func (c *serverCmd) GetV1TournamentId(ctx context.Context, request server_oapi.GetV1TournamentIdRequestObject) (server_oapi.GetV1TournamentIdResponseObject, error) {
+	tournament, err := c.tournamentsService.Get(ctx, request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament: %w", err)
+	}
+	return server_oapi.GetV1TournamentId200JSONResponse(toTournament(tournament)), nil
+}
+
+func (c *serverCmd) GetV1TournamentIdPair(ctx context.Context, request server_oapi.GetV1TournamentIdPairRequestObject) (server_oapi.GetV1TournamentIdPairResponseObject, error) {
+	first, second, err := c.tournamentsService.Pair(ctx, request.Id, request.Params.Participant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pair: %w", err)
+	}
+	return server_oapi.GetV1TournamentIdPair200JSONResponse{
+		First:  toStanding(first),
+		Second: toStanding(second),
+	}, nil
+}
+
+func (c *serverCmd) PostV1TournamentIdVote(ctx context.Context, request server_oapi.PostV1TournamentIdVoteRequestObject) (server_oapi.PostV1TournamentIdVoteResponseObject, error) {
+	winner, loser, err := c.tournamentsService.Vote(ctx, request.Id, request.Params.Participant, request.Body.Winner, request.Body.Loser)
+	if err != nil {
+		return nil, fmt.Errorf("failed to vote: %w", err)
+	}
+	return server_oapi.PostV1TournamentIdVote200JSONResponse{
+		First:  toStanding(winner),
+		Second: toStanding(loser),
+	}, nil
+}
+
+func (c *serverCmd) GetV1TournamentIdLeaderboard(ctx context.Context, request server_oapi.GetV1TournamentIdLeaderboardRequestObject) (server_oapi.GetV1TournamentIdLeaderboardResponseObject, error) {
+	var participant string
+	if request.Params.Participant != nil {
+		participant = *request.Params.Participant
+	}
+	standings, err := c.tournamentsService.Leaderboard(ctx, request.Id, participant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard: %w", err)
+	}
+
+	// convert to output type
+	output := []server_oapi.Standing{}
+	for _, standing := range standings {
+		output = append(output, toStanding(standing))
+	}
+	return server_oapi.GetV1TournamentIdLeaderboard200JSONResponse{
+		Participant: request.Params.Participant,
+		Standings:   output,
+	}, nil
+}
+
+func toTournament(tournament *models.Tournament) server_oapi.Tournament {
+	output := server_oapi.Tournament{
+		Id:          tournament.Id,
+		ShortlistId: tournament.ShortlistId,
+		CreatedAt:   tournament.CreatedAt,
+		Names:       []server_oapi.Contender{},
+		Games:       int64(len(tournament.Games)),
+	}
+	for i, contender := range tournament.Contenders {
+		output.Names = append(output.Names, server_oapi.Contender{
+			Id:     i,
+			Name:   contender.Value,
+			Gender: server_oapi.Gender(contender.Gender),
+		})
+	}
+	return output
+}
+
+func toStanding(standing *models.Standing) server_oapi.Standing {
+	return server_oapi.Standing{
+		Id:     standing.Id,
+		Name:   standing.Contender.Value,
+		Gender: server_oapi.Gender(standing.Contender.Gender),
+		Rating: standing.Rating,
+		Games:  standing.Games,
+		Wins:   standing.Wins,
+	}
+}
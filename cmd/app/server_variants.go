@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	server_oapi "github.com/mwasilew2/go-service-template/gen/server-oapi"
+)
+
+func (c *serverCmd) GetV1NameIdVariants(ctx context.Context, request server_oapi.GetV1NameIdVariantsRequestObject) (server_oapi.GetV1NameIdVariantsResponseObject, error) {
+	// year
+	year, err := c.parseYear(ctx, request.Params.Year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse year: %w", err)
+	}
+
+	// get name
+	name, err := c.namesService.GetName(ctx, year, request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get name: %w", err)
+	}
+
+	// get variants
+	variants, err := c.variantsService.GetVariants(ctx, year, name.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variants: %w", err)
+	}
+
+	// convert to output type
+	output := []server_oapi.NameVariant{}
+	for _, variant := range variants {
+		entries := []server_oapi.NameEntry{}
+		for _, entry := range variant.Entries {
+			entries = append(entries, toNameEntry(entry))
+		}
+		output = append(output, server_oapi.NameVariant{
+			Name:      variant.Value,
+			Kind:      server_oapi.NameVariantKind(variant.Kind),
+			Languages: append([]string{}, variant.Languages...),
+			Entries:   entries,
+		})
+	}
+	return server_oapi.GetV1NameIdVariants200JSONResponse{
+		Name:     toNameEntry(name),
+		Variants: output,
+	}, nil
+}
@@ -3,18 +3,36 @@ package main
 import (
 	"bufio"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 
 	"golang.org/x/exp/slog"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+const (
+	transformModeTruncate = "truncate"
+	transformModeAppend   = "append"
 )
 
+// yearInFilename matches a four digit year which isn't a part of a longer number, e.g. names_2023.csv
+var yearInFilename = regexp.MustCompile(`(?:^|[^0-9])([0-9]{4})(?:[^0-9]|$)`)
+
 type transformCmd struct {
 	// cli options
-	InputFilepath  string `help:"path to the file to transform" type:"existingfile" default:"./internal/adapters/namesdb/names.csv"`
-	OutputFilepath string `help:"path to the file to write the transformed data to" type:"string" default:"./internal/adapters/namesdb/names_transformed.csv"`
-	Year           string `help:"year of the data to transform" required:"" type:"string"`
+	InputFilepath  string            `help:"path to the file to transform, used only if neither --input nor --input-dir is given" type:"path" default:"./internal/adapters/namesdb/names.csv"`
+	Year           string            `help:"year of the data in --input-filepath" type:"string"`
+	Inputs         map[string]string `name:"input" help:"year and path of a file to transform, e.g. --input 2023=names_2023.csv, can be repeated" placeholder:"YEAR=PATH"`
+	InputDir       string            `help:"directory with files to transform, the year is taken from each file name, e.g. names_2023.csv" type:"existingdir"`
+	OutputFilepath string            `help:"path to the file to write the transformed data to" type:"string" default:"./internal/adapters/namesdb/names_transformed.csv"`
+	Mode           string            `help:"truncate the output file or append to it, appending a year which is already in the output file is an error" enum:"truncate,append" default:"truncate"`
 	// Dependencies
 	logger *slog.Logger
 }
@@ -22,27 +40,168 @@ type transformCmd struct {
 func (c *transformCmd) Run(cmdCtx *cmdContext) error {
 	c.logger = cmdCtx.Logger.With("component", "transformCmd")
 
-	// open source file
-	fd, err := os.Open(c.InputFilepath)
+	inputs, err := c.collectInputs()
 	if err != nil {
-		return fmt.Errorf("failed to open a source file descriptor: %w", err)
+		return err
+	}
+	years := make([]int64, 0, len(inputs))
+	for year := range inputs {
+		years = append(years, year)
+	}
+	sort.Slice(years, func(i, j int) bool { return years[i] < years[j] })
+
+	// the output is written to a temporary file first, so that a failure never leaves a half-written output behind
+	ofd, err := os.CreateTemp(filepath.Dir(c.OutputFilepath), filepath.Base(c.OutputFilepath)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create a temporary destination file: %w", err)
+	}
+	defer func() {
+		// no-ops if the temporary file has been renamed already
+		ofd.Close()
+		os.Remove(ofd.Name())
+	}()
+	// both copied and transformed records are written as CSV, so that names with commas or quotes are quoted
+	w := csv.NewWriter(ofd)
+
+	// copy the data which is already in the destination file
+	if c.Mode == transformModeAppend {
+		existingYears, err := c.copyExisting(w)
+		if err != nil {
+			return err
+		}
+		for _, year := range years {
+			if _, ok := existingYears[year]; ok {
+				return fmt.Errorf("year %d is already in %s", year, c.OutputFilepath)
+			}
+		}
+	}
+
+	// transform every year, ids are assigned per year starting from 0
+	for _, year := range years {
+		if err := c.transformFile(w, year, inputs[year]); err != nil {
+			return err
+		}
+	}
+
+	// replace the destination file
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("failed to write to destination file %s: %w", ofd.Name(), err)
+	}
+	if err := ofd.Sync(); err != nil {
+		return fmt.Errorf("failed to sync destination file %s: %w", ofd.Name(), err)
+	}
+	if err := ofd.Chmod(0644); err != nil {
+		return fmt.Errorf("failed to set permissions of destination file %s: %w", ofd.Name(), err)
+	}
+	if err := ofd.Close(); err != nil {
+		return fmt.Errorf("failed to close destination file %s: %w", ofd.Name(), err)
+	}
+	if err := os.Rename(ofd.Name(), c.OutputFilepath); err != nil {
+		return fmt.Errorf("failed to replace destination file %s: %w", c.OutputFilepath, err)
+	}
+	c.logger.Info("transformed data written", "output", c.OutputFilepath, "years", years, "mode", c.Mode)
+
+	return nil
+}
+
+// collectInputs returns the files to transform, keyed by year
+func (c *transformCmd) collectInputs() (map[int64]string, error) {
+	inputs := make(map[int64]string)
+	add := func(year string, path string) error {
+		yearInt, err := strconv.ParseInt(year, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse year %s of %s: %w", year, path, err)
+		}
+		if existing, ok := inputs[yearInt]; ok {
+			return fmt.Errorf("year %d given for both %s and %s", yearInt, existing, path)
+		}
+		inputs[yearInt] = path
+		return nil
+	}
+
+	for year, path := range c.Inputs {
+		if err := add(year, path); err != nil {
+			return nil, err
+		}
+	}
+	if c.InputDir != "" {
+		files, err := filepath.Glob(filepath.Join(c.InputDir, "*.csv"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files in %s: %w", c.InputDir, err)
+		}
+		for _, file := range files {
+			match := yearInFilename.FindStringSubmatch(filepath.Base(file))
+			if match == nil {
+				c.logger.Warn("skipping a file without a year in its name", "file", file)
+				continue
+			}
+			if err := add(match[1], file); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(inputs) == 0 {
+		if c.Year == "" {
+			return nil, errors.New("--year is required when transforming --input-filepath")
+		}
+		if err := add(c.Year, c.InputFilepath); err != nil {
+			return nil, err
+		}
+	}
+
+	return inputs, nil
+}
+
+// copyExisting copies the destination file, if it exists, and returns the years it contains
+func (c *transformCmd) copyExisting(w *csv.Writer) (map[int64]struct{}, error) {
+	years := make(map[int64]struct{})
+	fd, err := os.Open(c.OutputFilepath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return years, nil
+		}
+		return nil, fmt.Errorf("failed to open destination file %s: %w", c.OutputFilepath, err)
 	}
 	defer fd.Close()
-	bfd := bufio.NewReader(fd)
 
-	// open destination file
-	ofd, err := os.OpenFile(c.OutputFilepath, os.O_CREATE|os.O_WRONLY, 0644)
+	r := csv.NewReader(bufio.NewReader(fd))
+	r.FieldsPerRecord = 5 // year,id,name,gender,count
+	for {
+		record, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("failed to read destination file %s: %w", c.OutputFilepath, err)
+		}
+		year, err := strconv.ParseInt(record[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse year %s in %s: %w", record[0], c.OutputFilepath, err)
+		}
+		years[year] = struct{}{}
+		if err := w.Write(record); err != nil {
+			return nil, fmt.Errorf("failed to copy destination file %s: %w", c.OutputFilepath, err)
+		}
+	}
+
+	return years, nil
+}
+
+func (c *transformCmd) transformFile(w *csv.Writer, year int64, inputFilepath string) error {
+	// open source file
+	fd, err := os.Open(inputFilepath)
 	if err != nil {
-		return fmt.Errorf("failed to open a destination file descriptor: %w", err)
+		return fmt.Errorf("failed to open a source file descriptor: %w", err)
 	}
-	defer ofd.Close()
-	bofd := bufio.NewWriter(ofd)
-	defer bofd.Flush()
+	defer fd.Close()
+	bfd := bufio.NewReader(fd)
 
 	// read the file line by line
 	var lastId int64
 	r := csv.NewReader(bfd)
-	r.Read() // skip the header
+	r.FieldsPerRecord = 3 // name,gender,count
+	r.Read()              // skip the header
 FILE_READING_LOOP:
 	for {
 		record, err := r.Read()
@@ -51,13 +210,27 @@ FILE_READING_LOOP:
 			case err == io.EOF:
 				break FILE_READING_LOOP
 			default:
-				return fmt.Errorf("failed to read source file %s: %w", c.InputFilepath, err)
+				return fmt.Errorf("failed to read source file %s: %w", inputFilepath, err)
 			}
 		}
 		c.logger.Debug("read a record", "record", record)
 		name := record[0]
+		gender, err := models.ParseGender(record[1])
+		if err != nil {
+			return fmt.Errorf("failed to parse gender %s of %s: %w", record[1], name, err)
+		}
+		count, err := strconv.ParseInt(record[2], 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse count %s of %s: %w", record[2], name, err)
+		}
 		// write the transformed data to the destination file
-		_, err = bofd.WriteString(fmt.Sprintf("%s,%d,%s\n", c.Year, lastId, name))
+		err = w.Write([]string{
+			strconv.FormatInt(year, 10),
+			strconv.FormatInt(lastId, 10),
+			name,
+			string(gender),
+			strconv.FormatInt(count, 10),
+		})
 		if err != nil {
 			return fmt.Errorf("failed to write to destination file %s: %w", c.OutputFilepath, err)
 		}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/mwasilew2/go-service-template/internal/adapters/namesdb"
+)
+
+func TestTransformQuotesNames(t *testing.T) {
+	sourceDir := t.TempDir()
+	outputDir := t.TempDir()
+	writeSource := func(filename string, data string) string {
+		path := filepath.Join(sourceDir, filename)
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatalf("failed to write source file: %v", err)
+		}
+		return path
+	}
+	cmdCtx := &cmdContext{Logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	output := filepath.Join(outputDir, "names_transformed.csv")
+
+	// transform a year, then append another one, so that both the transformed and the copied records are checked
+	steps := []struct {
+		mode   string
+		year   string
+		source string
+	}{
+		{transformModeTruncate, "2022", "name,gender,count\n\"ANNA, MARIA\",KOBIETA,5\nJAN,MĘŻCZYZNA,3\n"},
+		{transformModeAppend, "2023", "name,gender,count\n\"O\"\"NEIL\",MĘŻCZYZNA,2\n"},
+	}
+	for _, step := range steps {
+		cmd := &transformCmd{
+			Inputs:         map[string]string{step.year: writeSource("names_"+step.year+".csv", step.source)},
+			OutputFilepath: output,
+			Mode:           step.mode,
+		}
+		if err := cmd.Run(cmdCtx); err != nil {
+			t.Fatalf("failed to transform %s: %v", step.year, err)
+		}
+	}
+
+	namesDB, err := namesdb.NewNamesDB(outputDir)
+	if err != nil {
+		t.Fatalf("failed to load transformed names: %v", err)
+	}
+	tests := []struct {
+		year  int64
+		id    int64
+		value string
+	}{
+		{2022, 0, "ANNA, MARIA"},
+		{2022, 1, "JAN"},
+		{2023, 0, `O"NEIL`},
+	}
+	for _, tt := range tests {
+		name, err := namesDB.GetName(context.Background(), tt.year, tt.id)
+		if err != nil {
+			t.Fatalf("failed to get name %d from %d: %v", tt.id, tt.year, err)
+		}
+		if name.Value != tt.value {
+			t.Errorf("expected %q, got %q", tt.value, name.Value)
+		}
+	}
+}
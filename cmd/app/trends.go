@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"golang.org/x/exp/slog"
+
+	"github.com/mwasilew2/go-service-template/internal/adapters/namesdb"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/trends"
+)
+
+type trendsCmd struct {
+	// cli options
+	From    int64  `help:"year to compare from" required:""`
+	To      int64  `help:"year to compare to" required:""`
+	Limit   int64  `help:"maximum number of names in each list" default:"20"`
+	By      string `help:"what risers and fallers are ordered by" enum:"rank,share" default:"rank"`
+	Format  string `help:"output format" enum:"text,json" default:"text"`
+	DataDir string `help:"directory with per-year name datasets (*.csv) and optionally metadata of names (metadata.json), the embedded ones are used if not set" type:"existingdir" env:"DATA_DIR"`
+
+	// Dependencies
+	logger *slog.Logger
+}
+
+func (c *trendsCmd) Run(cmdCtx *cmdContext) error {
+	c.logger = cmdCtx.Logger.With("component", "trendsCmd")
+
+	// initialize dependencies
+	namesDB, err := namesdb.NewNamesDB(c.DataDir)
+	if err != nil {
+		return fmt.Errorf("failed to initialize names service: %w", err)
+	}
+	trendsService := trends.NewService(namesDB)
+
+	// generate report
+	report, err := trendsService.Report(context.Background(), c.From, c.To, trends.Metric(c.By), c.Limit)
+	if err != nil {
+		return fmt.Errorf("failed to generate trends report: %w", err)
+	}
+	c.logger.Debug("generated trends report", "from", c.From, "to", c.To, "by", c.By)
+
+	if c.Format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+	return writeTrendsReport(os.Stdout, report)
+}
+
+func writeTrendsReport(out io.Writer, report *models.TrendsReport) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	sections := []struct {
+		title  string
+		trends []*models.Trend
+	}{
+		{"Risers", report.Risers},
+		{"Fallers", report.Fallers},
+		{"New entrants", report.NewEntrants},
+		{"Dropouts", report.Dropouts},
+	}
+	for _, section := range sections {
+		fmt.Fprintf(w, "%s (%d -> %d)\n", section.title, report.From, report.To)
+		fmt.Fprintln(w, "NAME\tGENDER\tRANK\tCOUNT\tRANK CHANGE\tSHARE CHANGE")
+		for _, trend := range section.trends {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				trend.Value,
+				trend.Gender,
+				fromTo(trend, func(n *models.Name) string { return fmt.Sprint(n.Rank) }),
+				fromTo(trend, func(n *models.Name) string { return fmt.Sprint(n.Count) }),
+				changeOrDash(trend, fmt.Sprintf("%+d", trend.RankChange)),
+				changeOrDash(trend, fmt.Sprintf("%+.4f%%", trend.ShareChange*100)),
+			)
+		}
+		fmt.Fprintln(w)
+	}
+	return w.Flush()
+}
+
+// fromTo formats a value in both years, a dash stands for a year in which the name wasn't given
+func fromTo(trend *models.Trend, value func(*models.Name) string) string {
+	from, to := "-", "-"
+	if trend.From != nil {
+		from = value(trend.From)
+	}
+	if trend.To != nil {
+		to = value(trend.To)
+	}
+	return from + " -> " + to
+}
+
+func changeOrDash(trend *models.Trend, change string) string {
+	if trend.From == nil || trend.To == nil {
+		return "-"
+	}
+	return change
+}
@@ -23,6 +23,11 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AppServerClient interface {
 	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	GetName(ctx context.Context, in *GetNameRequest, opts ...grpc.CallOption) (*GetNameResponse, error)
+	ListNames(ctx context.Context, in *ListNamesRequest, opts ...grpc.CallOption) (*ListNamesResponse, error)
+	ListYears(ctx context.Context, in *ListYearsRequest, opts ...grpc.CallOption) (*ListYearsResponse, error)
+	CountNames(ctx context.Context, in *CountNamesRequest, opts ...grpc.CallOption) (*CountNamesResponse, error)
+	StreamNames(ctx context.Context, in *StreamNamesRequest, opts ...grpc.CallOption) (AppServer_StreamNamesClient, error)
 }
 
 type appServerClient struct {
@@ -42,11 +47,84 @@ func (c *appServerClient) Send(ctx context.Context, in *SendRequest, opts ...grp
 	return out, nil
 }
 
+func (c *appServerClient) GetName(ctx context.Context, in *GetNameRequest, opts ...grpc.CallOption) (*GetNameResponse, error) {
+	out := new(GetNameResponse)
+	err := c.cc.Invoke(ctx, "/server_grpc.AppServer/GetName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServerClient) ListNames(ctx context.Context, in *ListNamesRequest, opts ...grpc.CallOption) (*ListNamesResponse, error) {
+	out := new(ListNamesResponse)
+	err := c.cc.Invoke(ctx, "/server_grpc.AppServer/ListNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServerClient) ListYears(ctx context.Context, in *ListYearsRequest, opts ...grpc.CallOption) (*ListYearsResponse, error) {
+	out := new(ListYearsResponse)
+	err := c.cc.Invoke(ctx, "/server_grpc.AppServer/ListYears", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServerClient) CountNames(ctx context.Context, in *CountNamesRequest, opts ...grpc.CallOption) (*CountNamesResponse, error) {
+	out := new(CountNamesResponse)
+	err := c.cc.Invoke(ctx, "/server_grpc.AppServer/CountNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServerClient) StreamNames(ctx context.Context, in *StreamNamesRequest, opts ...grpc.CallOption) (AppServer_StreamNamesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AppServer_ServiceDesc.Streams[0], "/server_grpc.AppServer/StreamNames", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appServerStreamNamesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppServer_StreamNamesClient interface {
+	Recv() (*StreamNamesResponse, error)
+	grpc.ClientStream
+}
+
+type appServerStreamNamesClient struct {
+	grpc.ClientStream
+}
+
+func (x *appServerStreamNamesClient) Recv() (*StreamNamesResponse, error) {
+	m := new(StreamNamesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AppServerServer is the server API for AppServer service.
 // All implementations must embed UnimplementedAppServerServer
 // for forward compatibility
 type AppServerServer interface {
 	Send(context.Context, *SendRequest) (*SendResponse, error)
+	GetName(context.Context, *GetNameRequest) (*GetNameResponse, error)
+	ListNames(context.Context, *ListNamesRequest) (*ListNamesResponse, error)
+	ListYears(context.Context, *ListYearsRequest) (*ListYearsResponse, error)
+	CountNames(context.Context, *CountNamesRequest) (*CountNamesResponse, error)
+	StreamNames(*StreamNamesRequest, AppServer_StreamNamesServer) error
 	mustEmbedUnimplementedAppServerServer()
 }
 
@@ -57,6 +135,21 @@ type UnimplementedAppServerServer struct {
 func (UnimplementedAppServerServer) Send(context.Context, *SendRequest) (*SendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (UnimplementedAppServerServer) GetName(context.Context, *GetNameRequest) (*GetNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetName not implemented")
+}
+func (UnimplementedAppServerServer) ListNames(context.Context, *ListNamesRequest) (*ListNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNames not implemented")
+}
+func (UnimplementedAppServerServer) ListYears(context.Context, *ListYearsRequest) (*ListYearsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListYears not implemented")
+}
+func (UnimplementedAppServerServer) CountNames(context.Context, *CountNamesRequest) (*CountNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountNames not implemented")
+}
+func (UnimplementedAppServerServer) StreamNames(*StreamNamesRequest, AppServer_StreamNamesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNames not implemented")
+}
 func (UnimplementedAppServerServer) mustEmbedUnimplementedAppServerServer() {}
 
 // UnsafeAppServerServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +181,99 @@ func _AppServer_Send_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppServer_GetName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServerServer).GetName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server_grpc.AppServer/GetName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServerServer).GetName(ctx, req.(*GetNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppServer_ListNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServerServer).ListNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server_grpc.AppServer/ListNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServerServer).ListNames(ctx, req.(*ListNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppServer_ListYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListYearsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServerServer).ListYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server_grpc.AppServer/ListYears",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServerServer).ListYears(ctx, req.(*ListYearsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppServer_CountNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServerServer).CountNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server_grpc.AppServer/CountNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServerServer).CountNames(ctx, req.(*CountNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppServer_StreamNames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNamesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppServerServer).StreamNames(m, &appServerStreamNamesServer{stream})
+}
+
+type AppServer_StreamNamesServer interface {
+	Send(*StreamNamesResponse) error
+	grpc.ServerStream
+}
+
+type appServerStreamNamesServer struct {
+	grpc.ServerStream
+}
+
+func (x *appServerStreamNamesServer) Send(m *StreamNamesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // AppServer_ServiceDesc is the grpc.ServiceDesc for AppServer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,7 +285,29 @@ var AppServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Send",
 			Handler:    _AppServer_Send_Handler,
 		},
+		{
+			MethodName: "GetName",
+			Handler:    _AppServer_GetName_Handler,
+		},
+		{
+			MethodName: "ListNames",
+			Handler:    _AppServer_ListNames_Handler,
+		},
+		{
+			MethodName: "ListYears",
+			Handler:    _AppServer_ListYears_Handler,
+		},
+		{
+			MethodName: "CountNames",
+			Handler:    _AppServer_CountNames_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamNames",
+			Handler:       _AppServer_StreamNames_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "server.proto",
 }
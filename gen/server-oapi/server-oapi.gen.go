@@ -15,13 +15,130 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 )
 
-// Error defines model for Error.
+// Defines values for FieldErrorIn.
+const (
+	Body   FieldErrorIn = "body"
+	Cookie FieldErrorIn = "cookie"
+	Header FieldErrorIn = "header"
+	Path   FieldErrorIn = "path"
+	Query  FieldErrorIn = "query"
+)
+
+// Defines values for Gender.
+const (
+	Female Gender = "female"
+	Male   Gender = "male"
+)
+
+// Defines values for NameVariantKind.
+const (
+	Canonical  NameVariantKind = "canonical"
+	Diminutive NameVariantKind = "diminutive"
+	Foreign    NameVariantKind = "foreign"
+)
+
+// Defines values for GetV1NameParamsSort.
+const (
+	Count     GetV1NameParamsSort = "count"
+	Id        GetV1NameParamsSort = "id"
+	Length    GetV1NameParamsSort = "length"
+	Name      GetV1NameParamsSort = "name"
+	Syllables GetV1NameParamsSort = "syllables"
+)
+
+// Defines values for GetV1NameParamsOrder.
+const (
+	Asc  GetV1NameParamsOrder = "asc"
+	Desc GetV1NameParamsOrder = "desc"
+)
+
+// Defines values for GetV1TrendsParamsBy.
+const (
+	Rank  GetV1TrendsParamsBy = "rank"
+	Share GetV1TrendsParamsBy = "share"
+)
+
+// Celebration defines model for Celebration.
+type Celebration struct {
+	// Entries entries of the name in the year, one per gender, empty if the name wasn't given in that year
+	Entries []NameEntry `json:"entries"`
+
+	// Name the name, spelled like in the calendar
+	Name string `json:"name"`
+}
+
+// CompatibilityEntry defines model for CompatibilityEntry.
+type CompatibilityEntry struct {
+	// AwkwardInitials whether the initials may be read as an embarrassing abbreviation
+	AwkwardInitials bool `json:"awkwardInitials"`
+
+	// BoundaryRepeat whether the name ends with the sound the surname starts with
+	BoundaryRepeat bool `json:"boundaryRepeat"`
+
+	// Gender the gender of people given the name
+	Gender *Gender `json:"gender,omitempty"`
+
+	// Initials the initials, e.g. J.K.
+	Initials string `json:"initials"`
+
+	// Known whether the name is in any of the datasets
+	Known bool `json:"known"`
+
+	// Name the name, spelled like in the datasets if it's there
+	Name string `json:"name"`
+
+	// Rhythm the syllables of the name and of the surname, e.g. 2-3
+	Rhythm string `json:"rhythm"`
+
+	// Syllables the number of syllables of the name and the surname together
+	Syllables int64      `json:"syllables"`
+	Traits    NameTraits `json:"traits"`
+
+	// VowelClash whether the name ends and the surname starts with a vowel
+	VowelClash bool `json:"vowelClash"`
+
+	// Warnings human readable descriptions of potential problems
+	Warnings []string `json:"warnings"`
+}
+
+// CompatibilityRequest defines model for CompatibilityRequest.
+type CompatibilityRequest struct {
+	// Names the first names to check, they're looked up in the datasets ignoring case, but not diacritics, like by /v1/name/by-value/{name}
+	Names []string `json:"names"`
+
+	// Surname the surname, double-barrelled surnames are separated with a hyphen
+	Surname string `json:"surname"`
+}
+
+// CompatibilityResponse defines model for CompatibilityResponse.
+type CompatibilityResponse struct {
+	// Results heuristics for every name
+	Results []CompatibilityEntry `json:"results"`
+
+	// Surname the surname
+	Surname string `json:"surname"`
+}
+
+// Contender defines model for Contender.
+type Contender struct {
+	// Gender the gender of people given the name
+	Gender Gender `json:"gender"`
+
+	// Id the ID of the name in the tournament
+	Id int `json:"id"`
+
+	// Name the name
+	Name string `json:"name"`
+}
+
+// Error the legacy error object, returned instead of Problem to clients which prefer application/json
 type Error struct {
 	// Code Error code
 	Code int32 `json:"code"`
@@ -30,10 +147,173 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// In where the parameter is
+	In FieldErrorIn `json:"in"`
+
+	// Message what's wrong with the parameter
+	Message string `json:"message"`
+
+	// Name the name of the parameter
+	Name string `json:"name"`
+}
+
+// FieldErrorIn where the parameter is
+type FieldErrorIn string
+
+// Gender the gender of people given the name
+type Gender string
+
+// LeaderboardResponse defines model for LeaderboardResponse.
+type LeaderboardResponse struct {
+	// Participant a name of a participant chosen by the participant, e.g. mum, it tells rankings of participants apart but isn't authenticated
+	Participant *Participant `json:"participant,omitempty"`
+
+	// Standings the names, the best rated first
+	Standings []Standing `json:"standings"`
+}
+
+// Match defines model for Match.
+type Match struct {
+	// Gender the gender of people given the name
+	Gender Gender `json:"gender"`
+
+	// MatchedAt when the last participant liked the name
+	MatchedAt time.Time `json:"matchedAt"`
+
+	// Name the name
+	Name string `json:"name"`
+}
+
+// MatchesResponse defines model for MatchesResponse.
+type MatchesResponse struct {
+	// Matches the matches
+	Matches []Match `json:"matches"`
+}
+
+// NameDaysOnResponse defines model for NameDaysOnResponse.
+type NameDaysOnResponse struct {
+	// Date the day, formatted as MM-DD
+	Date string `json:"date"`
+
+	// Names names celebrated on the day, the most popular first
+	Names []Celebration `json:"names"`
+
+	// Year the year popularity of names is taken from
+	Year int64 `json:"year"`
+}
+
+// NameDaysResponse defines model for NameDaysResponse.
+type NameDaysResponse struct {
+	// Dates days on which the name is celebrated, formatted as MM-DD
+	Dates []string  `json:"dates"`
+	Name  NameEntry `json:"name"`
+}
+
 // NameEntry defines model for NameEntry.
 type NameEntry struct {
+	// Count the number of occurrences of the name in a given year
+	Count *int64 `json:"count,omitempty"`
+
+	// Gender the gender of people given the name
+	Gender *Gender `json:"gender,omitempty"`
+
+	// Id the ID of the name in a given year
+	Id *int64 `json:"id,omitempty"`
+
+	// Meta the meaning and origin of a name, only if they're known
+	Meta *NameMeta `json:"meta,omitempty"`
+
 	// Name the name
 	Name *string `json:"name,omitempty"`
+
+	// Rank the position of the name among names of the same gender in a given year
+	Rank   *int64      `json:"rank,omitempty"`
+	Traits *NameTraits `json:"traits,omitempty"`
+}
+
+// NameHistoryEntry defines model for NameHistoryEntry.
+type NameHistoryEntry struct {
+	// Count the number of occurrences of the name in the year, 0 if the name wasn't given
+	Count int64 `json:"count"`
+
+	// Gender the gender of people given the name
+	Gender *Gender `json:"gender,omitempty"`
+
+	// Id the ID of the name in the year, missing if the name wasn't given
+	Id *int64 `json:"id,omitempty"`
+
+	// Rank the position of the name among names of the same gender in the year, missing if the name wasn't given
+	Rank *int64 `json:"rank,omitempty"`
+
+	// Year the year
+	Year int64 `json:"year"`
+}
+
+// NameHistoryResponse defines model for NameHistoryResponse.
+type NameHistoryResponse struct {
+	// History the name in every available year, ordered by year
+	History []NameHistoryEntry `json:"history"`
+
+	// Name the name
+	Name string `json:"name"`
+}
+
+// NameMeta the meaning and origin of a name, only if they're known
+type NameMeta struct {
+	// Etymology how the name came to be
+	Etymology string `json:"etymology"`
+
+	// Meaning what the name means
+	Meaning string `json:"meaning"`
+
+	// Origin the language or language family the name comes from, e.g. Slavic, Hebrew, Latin
+	Origin string `json:"origin"`
+}
+
+// NameTraits defines model for NameTraits.
+type NameTraits struct {
+	// Ending the last letter
+	Ending string `json:"ending"`
+
+	// Initial the first letter
+	Initial string `json:"initial"`
+
+	// Length the number of letters
+	Length int64 `json:"length"`
+
+	// Pattern C for every consonant and V for every vowel, e.g. CVCVC for JAKUB
+	Pattern string `json:"pattern"`
+
+	// Syllables the number of syllables, following Polish spelling
+	Syllables int64 `json:"syllables"`
+}
+
+// NameVariant defines model for NameVariant.
+type NameVariant struct {
+	// Entries entries of the variant in the year, one per gender, empty if the variant wasn't given in that year
+	Entries []NameEntry `json:"entries"`
+
+	// Kind how the variant relates to the canonical form of the name
+	Kind NameVariantKind `json:"kind"`
+
+	// Languages languages of a foreign form, e.g. en, de
+	Languages []string `json:"languages"`
+
+	// Name the variant, spelled like in the variants dataset
+	Name string `json:"name"`
+}
+
+// NameVariantKind how the variant relates to the canonical form of the name
+type NameVariantKind string
+
+// NameVariantsResponse defines model for NameVariantsResponse.
+type NameVariantsResponse struct {
+	Name NameEntry `json:"name"`
+
+	// Variants canonical forms first, then diminutives, then foreign forms
+	Variants []NameVariant `json:"variants"`
 }
 
 // NamesPageResponse defines model for NamesPageResponse.
@@ -44,8 +324,14 @@ type NamesPageResponse struct {
 	// Names the names
 	Names []NameEntry `json:"names"`
 
-	// Page the page number
-	Page int64 `json:"page"`
+	// Next a cursor pointing at the names following the returned ones, missing on the last page
+	Next *string `json:"next,omitempty"`
+
+	// Page the page number, missing if the names were requested with a cursor
+	Page *int64 `json:"page,omitempty"`
+
+	// Prev a cursor pointing at the names preceding the returned ones, missing on the first page
+	Prev *string `json:"prev,omitempty"`
 
 	// Total the total number of items
 	Total int64 `json:"total"`
@@ -54,673 +340,9239 @@ type NamesPageResponse struct {
 	Year int64 `json:"year"`
 }
 
-// GetV1NameParams defines parameters for GetV1Name.
-type GetV1NameParams struct {
-	// Year the year of the name
-	Year *int64 `form:"year,omitempty" json:"year,omitempty"`
+// NamesRandomResponse defines model for NamesRandomResponse.
+type NamesRandomResponse struct {
+	// Names the names, in a random order
+	Names []NameEntry `json:"names"`
 
-	// Page the page number
-	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+	// Seed the seed of the random generator, pass it again to get the same names
+	Seed int64 `json:"seed"`
 
-	// Limit the number of items per page
-	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+	// Year the year of the names
+	Year int64 `json:"year"`
 }
 
-// GetV1NameIdParams defines parameters for GetV1NameId.
-type GetV1NameIdParams struct {
-	// Year the year of the name
-	Year *int64 `form:"year,omitempty" json:"year,omitempty"`
+// NamesSearchResponse defines model for NamesSearchResponse.
+type NamesSearchResponse struct {
+	// Names the names matching the query
+	Names []NameEntry `json:"names"`
+
+	// Query the query
+	Query string `json:"query"`
+
+	// Variants variants of the matching names which don't match the query themselves, only if variants were requested
+	Variants *[]NameEntry `json:"variants,omitempty"`
+
+	// Year the year of the names
+	Year int64 `json:"year"`
 }
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// Participant a name of a participant chosen by the participant, e.g. mum, it tells rankings of participants apart but isn't authenticated
+type Participant = string
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// Problem a problem details object, as described in RFC 7807
+type Problem struct {
+	// Detail an explanation specific to this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors problems with individual request parameters
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance the request which caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// Status the http status code
+	Status int32 `json:"status"`
+
+	// Title a short summary of the problem type
+	Title string `json:"title"`
+
+	// Type identifies the problem type
+	Type string `json:"type"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// Session defines model for Session.
+type Session struct {
+	// CreatedAt when the session was started
+	CreatedAt time.Time `json:"createdAt"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// Gender the gender of people given the name
+	Gender *Gender `json:"gender,omitempty"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// Id the ID of the session
+	Id string `json:"id"`
+
+	// Year the year names are served from
+	Year int64 `json:"year"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// SessionCreated defines model for SessionCreated.
+type SessionCreated struct {
+	// CreatedAt when the session was started
+	CreatedAt time.Time `json:"createdAt"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+	// Gender the gender of people given the name
+	Gender *Gender `json:"gender,omitempty"`
+
+	// Id the ID of the session
+	Id string `json:"id"`
+
+	// Invites an invite token for each participant, a participant identifies with it in all requests
+	Invites []string `json:"invites"`
+
+	// Year the year names are served from
+	Year int64 `json:"year"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+// SessionNextResponse defines model for SessionNextResponse.
+type SessionNextResponse struct {
+	Name *NameEntry `json:"name,omitempty"`
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+// SessionRequest defines model for SessionRequest.
+type SessionRequest struct {
+	// Gender the gender of people given the name
+	Gender *Gender `json:"gender,omitempty"`
+
+	// Year the year names are served from, the current year if missing
+	Year *int64 `json:"year,omitempty"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// GetV1Name request
-	GetV1Name(ctx context.Context, params *GetV1NameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Shortlist defines model for Shortlist.
+type Shortlist struct {
+	// CreatedAt when the shortlist was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	// GetV1NameId request
-	GetV1NameId(ctx context.Context, id int64, params *GetV1NameIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Id the ID of the shortlist, anyone who knows it can read and modify the shortlist
+	Id string `json:"id"`
+
+	// Names the names, in the order they were added
+	Names []ShortlistEntry `json:"names"`
 }
 
-func (c *Client) GetV1Name(ctx context.Context, params *GetV1NameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV1NameRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// ShortlistEntry defines model for ShortlistEntry.
+type ShortlistEntry struct {
+	// AddedAt when the name was added to the shortlist
+	AddedAt time.Time `json:"addedAt"`
+
+	// Gender the gender of people given the name
+	Gender Gender `json:"gender"`
+
+	// Name the name
+	Name string `json:"name"`
 }
 
-func (c *Client) GetV1NameId(ctx context.Context, id int64, params *GetV1NameIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetV1NameIdRequest(c.Server, id, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// ShortlistNameRequest defines model for ShortlistNameRequest.
+type ShortlistNameRequest struct {
+	// Gender the gender of people given the name
+	Gender Gender `json:"gender"`
+
+	// Name the name, it has to be given in at least one of the available years and spelled like in the datasets, case is ignored but diacritics aren't, like by /v1/name/by-value/{name}
+	Name string `json:"name"`
 }
 
-// NewGetV1NameRequest generates requests for GetV1Name
-func NewGetV1NameRequest(server string, params *GetV1NameParams) (*http.Request, error) {
-	var err error
+// SimilarName defines model for SimilarName.
+type SimilarName struct {
+	// EditDistance the number of edits between the names, case and diacritics are ignored
+	EditDistance int64     `json:"editDistance"`
+	Name         NameEntry `json:"name"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// PhoneticDistance the number of edits between phonetic codes of the names, 0 if they sound alike
+	PhoneticDistance int64 `json:"phoneticDistance"`
+}
 
-	operationPath := fmt.Sprintf("/v1/name")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// SimilarNamesResponse defines model for SimilarNamesResponse.
+type SimilarNamesResponse struct {
+	Name NameEntry `json:"name"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// Similar similar names, names which sound alike first, then names which are spelled alike
+	Similar []SimilarName `json:"similar"`
+}
 
-	queryValues := queryURL.Query()
+// Standing defines model for Standing.
+type Standing struct {
+	// Games the number of times the name was compared
+	Games int64 `json:"games"`
 
-	if params.Year != nil {
+	// Gender the gender of people given the name
+	Gender Gender `json:"gender"`
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	// Id the ID of the name in the tournament
+	Id int `json:"id"`
 
-	}
+	// Name the name
+	Name string `json:"name"`
 
-	if params.Page != nil {
+	// Rating the Elo rating of the name, names start at 1500
+	Rating float64 `json:"rating"`
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	// Wins the number of times the name was preferred
+	Wins int64 `json:"wins"`
+}
+
+// Tournament defines model for Tournament.
+type Tournament struct {
+	// CreatedAt when the tournament was started
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Games the number of votes of all participants
+	Games int64 `json:"games"`
+
+	// Id the ID of the tournament
+	Id string `json:"id"`
+
+	// Names the names taken from the shortlist when the tournament was started
+	Names []Contender `json:"names"`
+
+	// ShortlistId the ID of the shortlist the names were taken from
+	ShortlistId string `json:"shortlistId"`
+}
+
+// TournamentPair defines model for TournamentPair.
+type TournamentPair struct {
+	First  Standing `json:"first"`
+	Second Standing `json:"second"`
+}
+
+// TournamentRequest defines model for TournamentRequest.
+type TournamentRequest struct {
+	// ShortlistId the ID of the shortlist with names to rank, it has to have at least two names
+	ShortlistId string `json:"shortlistId"`
+}
+
+// TournamentVoteRequest defines model for TournamentVoteRequest.
+type TournamentVoteRequest struct {
+	// Loser the ID of the other name in the tournament
+	Loser int `json:"loser"`
+
+	// Winner the ID of the preferred name in the tournament
+	Winner int `json:"winner"`
+}
+
+// TrendEntry defines model for TrendEntry.
+type TrendEntry struct {
+	// FromCount the number of occurrences in the year compared from
+	FromCount *int64 `json:"fromCount,omitempty"`
+
+	// FromRank the rank in the year compared from, missing for new entrants
+	FromRank *int64 `json:"fromRank,omitempty"`
+
+	// Gender the gender of people given the name
+	Gender Gender `json:"gender"`
+
+	// Name the name
+	Name string `json:"name"`
+
+	// RankChange the number of places the name moved up the ranking, negative if it moved down
+	RankChange *int64 `json:"rankChange,omitempty"`
+
+	// ShareChange the change of the fraction of all occurrences of names of the same gender
+	ShareChange *float64 `json:"shareChange,omitempty"`
+
+	// ToCount the number of occurrences in the year compared to
+	ToCount *int64 `json:"toCount,omitempty"`
+
+	// ToRank the rank in the year compared to, missing for dropouts
+	ToRank *int64 `json:"toRank,omitempty"`
+}
+
+// TrendsResponse defines model for TrendsResponse.
+type TrendsResponse struct {
+	// By what risers and fallers are ordered by
+	By string `json:"by"`
+
+	// Dropouts names given only in the year compared from, the most popular first
+	Dropouts []TrendEntry `json:"dropouts"`
+
+	// Fallers names which lost the most
+	Fallers []TrendEntry `json:"fallers"`
+
+	// From the year compared from
+	From int64 `json:"from"`
+
+	// NewEntrants names given only in the year compared to, the most popular first
+	NewEntrants []TrendEntry `json:"newEntrants"`
+
+	// Risers names which gained the most
+	Risers []TrendEntry `json:"risers"`
+
+	// To the year compared to
+	To int64 `json:"to"`
+}
+
+// VoteRequest defines model for VoteRequest.
+type VoteRequest struct {
+	// Id the ID of the name in the year of the session
+	Id int64 `json:"id"`
+
+	// Like whether the participant likes the name
+	Like bool `json:"like"`
+}
+
+// VoteResponse defines model for VoteResponse.
+type VoteResponse struct {
+	Match *Match `json:"match,omitempty"`
+}
+
+// SessionId defines model for SessionId.
+type SessionId = string
+
+// SessionToken defines model for SessionToken.
+type SessionToken = string
+
+// TournamentId defines model for TournamentId.
+type TournamentId = string
+
+// GetV1NameParams defines parameters for GetV1Name.
+type GetV1NameParams struct {
+	// Year the year of the name
+	Year *int64 `form:"year,omitempty" json:"year,omitempty"`
+
+	// Page the page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit the number of items per page
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Gender return only names of a given gender
+	Gender *Gender `form:"gender,omitempty" json:"gender,omitempty"`
+
+	// Cursor a cursor returned as next or prev by a previous request, the year and the gender are taken from the cursor, can't be used together with page, filters other than gender, or sort
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// MinLength return only names with at least this many letters
+	MinLength *int64 `form:"minLength,omitempty" json:"minLength,omitempty"`
+
+	// MaxLength return only names with at most this many letters
+	MaxLength *int64 `form:"maxLength,omitempty" json:"maxLength,omitempty"`
+
+	// MinSyllables return only names with at least this many syllables
+	MinSyllables *int64 `form:"minSyllables,omitempty" json:"minSyllables,omitempty"`
+
+	// MaxSyllables return only names with at most this many syllables
+	MaxSyllables *int64 `form:"maxSyllables,omitempty" json:"maxSyllables,omitempty"`
+
+	// Initial return only names starting with given letters, case and diacritics are ignored
+	Initial *string `form:"initial,omitempty" json:"initial,omitempty"`
+
+	// Ending return only names ending with given letters, case and diacritics are ignored
+	Ending *string `form:"ending,omitempty" json:"ending,omitempty"`
+
+	// Origin return only names of a given origin, e.g. Slavic, Hebrew or Latin, case is ignored
+	Origin *string `form:"origin,omitempty" json:"origin,omitempty"`
+
+	// Sort what names are sorted by, names with equal values are sorted by ID
+	Sort *GetV1NameParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order the sort order
+	Order *GetV1NameParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetV1NameParamsSort defines parameters for GetV1Name.
+type GetV1NameParamsSort string
+
+// GetV1NameParamsOrder defines parameters for GetV1Name.
+type GetV1NameParamsOrder string
+
+// GetV1NameRandomParams defines parameters for GetV1NameRandom.
+type GetV1NameRandomParams struct {
+	// Year the year of the names
+	Year *int64 `form:"year,omitempty" json:"year,omitempty"`
+
+	// Gender return only names of a given gender
+	Gender *Gender `form:"gender,omitempty" json:"gender,omitempty"`
+
+	// Count the number of distinct names to return, fewer are returned if there aren't enough names
+	Count *int64 `form:"count,omitempty" json:"count,omitempty"`
+
+	// Weighted pick names proportionally to the number of occurrences instead of uniformly
+	Weighted *bool `form:"weighted,omitempty" json:"weighted,omitempty"`
+
+	// Seed a seed of the random generator, the same seed gives the same names as long as the dataset doesn't change. A random seed is used if missing, it's returned in the response.
+	Seed *int64 `form:"seed,omitempty" json:"seed,omitempty"`
+
+	// Exclude IDs of names which shouldn't be returned, e.g. names which were already seen
+	Exclude *[]int64 `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// GetV1NameSearchParams defines parameters for GetV1NameSearch.
+type GetV1NameSearchParams struct {
+	// Q the query, e.g. a prefix or a part of the name
+	Q string `form:"q" json:"q"`
+
+	// Year the year of the name
+	Year *int64 `form:"year,omitempty" json:"year,omitempty"`
+
+	// Limit the maximum number of names to return
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Variants whether variants of the matching names, e.g. diminutives and foreign forms, should be returned too
+	Variants *bool `form:"variants,omitempty" json:"variants,omitempty"`
+}
+
+// GetV1NameIdParams defines parameters for GetV1NameId.
+type GetV1NameIdParams struct {
+	// Year the year of the name
+	Year *int64 `form:"year,omitempty" json:"year,omitempty"`
+}
+
+// GetV1NameIdNamedaysParams defines parameters for GetV1NameIdNamedays.
+type GetV1NameIdNamedaysParams struct {
+	// Year the year of the name
+	Year *int64 `form:"year,omitempty" json:"year,omitempty"`
+}
+
+// GetV1NameIdSimilarParams defines parameters for GetV1NameIdSimilar.
+type GetV1NameIdSimilarParams struct {
+	// Year the year of the names
+	Year *int64 `form:"year,omitempty" json:"year,omitempty"`
+
+	// Limit the maximum number of names to return
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetV1NameIdVariantsParams defines parameters for GetV1NameIdVariants.
+type GetV1NameIdVariantsParams struct {
+	// Year the year of the name and of the entries of its variants
+	Year *int64 `form:"year,omitempty" json:"year,omitempty"`
+}
+
+// GetV1NamedaysParams defines parameters for GetV1Namedays.
+type GetV1NamedaysParams struct {
+	// Date the day, formatted as MM-DD, today if not set
+	Date *string `form:"date,omitempty" json:"date,omitempty"`
+
+	// Year the year popularity of names is taken from
+	Year *int64 `form:"year,omitempty" json:"year,omitempty"`
+}
+
+// GetV1SessionsIdParams defines parameters for GetV1SessionsId.
+type GetV1SessionsIdParams struct {
+	// Token the invite token of the participant
+	Token SessionToken `form:"token" json:"token"`
+}
+
+// GetV1SessionsIdMatchesParams defines parameters for GetV1SessionsIdMatches.
+type GetV1SessionsIdMatchesParams struct {
+	// Token the invite token of the participant
+	Token SessionToken `form:"token" json:"token"`
+}
+
+// GetV1SessionsIdMatchesStreamParams defines parameters for GetV1SessionsIdMatchesStream.
+type GetV1SessionsIdMatchesStreamParams struct {
+	// Token the invite token of the participant
+	Token SessionToken `form:"token" json:"token"`
+}
+
+// GetV1SessionsIdNextParams defines parameters for GetV1SessionsIdNext.
+type GetV1SessionsIdNextParams struct {
+	// Token the invite token of the participant
+	Token SessionToken `form:"token" json:"token"`
+}
+
+// PostV1SessionsIdVotesParams defines parameters for PostV1SessionsIdVotes.
+type PostV1SessionsIdVotesParams struct {
+	// Token the invite token of the participant
+	Token SessionToken `form:"token" json:"token"`
+}
+
+// DeleteV1ShortlistsIdNamesNameParams defines parameters for DeleteV1ShortlistsIdNamesName.
+type DeleteV1ShortlistsIdNamesNameParams struct {
+	// Gender remove the name only for a given gender, the name is removed for both genders if missing
+	Gender *Gender `form:"gender,omitempty" json:"gender,omitempty"`
+}
+
+// GetV1TournamentIdLeaderboardParams defines parameters for GetV1TournamentIdLeaderboard.
+type GetV1TournamentIdLeaderboardParams struct {
+	// Participant rank names by votes of a given participant, votes of all participants are used if missing
+	Participant *Participant `form:"participant,omitempty" json:"participant,omitempty"`
+}
+
+// GetV1TournamentIdPairParams defines parameters for GetV1TournamentIdPair.
+type GetV1TournamentIdPairParams struct {
+	// Participant the participant
+	Participant Participant `form:"participant" json:"participant"`
+}
+
+// PostV1TournamentIdVoteParams defines parameters for PostV1TournamentIdVote.
+type PostV1TournamentIdVoteParams struct {
+	// Participant the participant
+	Participant Participant `form:"participant" json:"participant"`
+}
+
+// GetV1TrendsParams defines parameters for GetV1Trends.
+type GetV1TrendsParams struct {
+	// From the year to compare from
+	From int64 `form:"from" json:"from"`
+
+	// To the year to compare to
+	To int64 `form:"to" json:"to"`
+
+	// Limit the maximum number of names in each list
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// By what risers and fallers are ordered by, the change of rank or the change of share of all occurrences
+	By *GetV1TrendsParamsBy `form:"by,omitempty" json:"by,omitempty"`
+}
+
+// GetV1TrendsParamsBy defines parameters for GetV1Trends.
+type GetV1TrendsParamsBy string
+
+// PostV1CompatibilityJSONRequestBody defines body for PostV1Compatibility for application/json ContentType.
+type PostV1CompatibilityJSONRequestBody = CompatibilityRequest
+
+// PostV1SessionsJSONRequestBody defines body for PostV1Sessions for application/json ContentType.
+type PostV1SessionsJSONRequestBody = SessionRequest
+
+// PostV1SessionsIdVotesJSONRequestBody defines body for PostV1SessionsIdVotes for application/json ContentType.
+type PostV1SessionsIdVotesJSONRequestBody = VoteRequest
+
+// PostV1ShortlistsIdNamesJSONRequestBody defines body for PostV1ShortlistsIdNames for application/json ContentType.
+type PostV1ShortlistsIdNamesJSONRequestBody = ShortlistNameRequest
+
+// PostV1TournamentJSONRequestBody defines body for PostV1Tournament for application/json ContentType.
+type PostV1TournamentJSONRequestBody = TournamentRequest
+
+// PostV1TournamentIdVoteJSONRequestBody defines body for PostV1TournamentIdVote for application/json ContentType.
+type PostV1TournamentIdVoteJSONRequestBody = TournamentVoteRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// PostV1Compatibility request with any body
+	PostV1CompatibilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1Compatibility(ctx context.Context, body PostV1CompatibilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Name request
+	GetV1Name(ctx context.Context, params *GetV1NameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1NameByValueName request
+	GetV1NameByValueName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1NameRandom request
+	GetV1NameRandom(ctx context.Context, params *GetV1NameRandomParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1NameSearch request
+	GetV1NameSearch(ctx context.Context, params *GetV1NameSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1NameId request
+	GetV1NameId(ctx context.Context, id int64, params *GetV1NameIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1NameIdNamedays request
+	GetV1NameIdNamedays(ctx context.Context, id int64, params *GetV1NameIdNamedaysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1NameIdSimilar request
+	GetV1NameIdSimilar(ctx context.Context, id int64, params *GetV1NameIdSimilarParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1NameIdVariants request
+	GetV1NameIdVariants(ctx context.Context, id int64, params *GetV1NameIdVariantsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Namedays request
+	GetV1Namedays(ctx context.Context, params *GetV1NamedaysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1Sessions request with any body
+	PostV1SessionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1Sessions(ctx context.Context, body PostV1SessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1SessionsId request
+	GetV1SessionsId(ctx context.Context, id SessionId, params *GetV1SessionsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1SessionsIdMatches request
+	GetV1SessionsIdMatches(ctx context.Context, id SessionId, params *GetV1SessionsIdMatchesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1SessionsIdMatchesStream request
+	GetV1SessionsIdMatchesStream(ctx context.Context, id SessionId, params *GetV1SessionsIdMatchesStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1SessionsIdNext request
+	GetV1SessionsIdNext(ctx context.Context, id SessionId, params *GetV1SessionsIdNextParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1SessionsIdVotes request with any body
+	PostV1SessionsIdVotesWithBody(ctx context.Context, id SessionId, params *PostV1SessionsIdVotesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1SessionsIdVotes(ctx context.Context, id SessionId, params *PostV1SessionsIdVotesParams, body PostV1SessionsIdVotesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1Shortlists request
+	PostV1Shortlists(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ShortlistsId request
+	GetV1ShortlistsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ShortlistsIdNames request with any body
+	PostV1ShortlistsIdNamesWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1ShortlistsIdNames(ctx context.Context, id string, body PostV1ShortlistsIdNamesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1ShortlistsIdNamesName request
+	DeleteV1ShortlistsIdNamesName(ctx context.Context, id string, name string, params *DeleteV1ShortlistsIdNamesNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1Tournament request with any body
+	PostV1TournamentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1Tournament(ctx context.Context, body PostV1TournamentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TournamentId request
+	GetV1TournamentId(ctx context.Context, id TournamentId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TournamentIdLeaderboard request
+	GetV1TournamentIdLeaderboard(ctx context.Context, id TournamentId, params *GetV1TournamentIdLeaderboardParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1TournamentIdPair request
+	GetV1TournamentIdPair(ctx context.Context, id TournamentId, params *GetV1TournamentIdPairParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1TournamentIdVote request with any body
+	PostV1TournamentIdVoteWithBody(ctx context.Context, id TournamentId, params *PostV1TournamentIdVoteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1TournamentIdVote(ctx context.Context, id TournamentId, params *PostV1TournamentIdVoteParams, body PostV1TournamentIdVoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Trends request
+	GetV1Trends(ctx context.Context, params *GetV1TrendsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) PostV1CompatibilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1CompatibilityRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1Compatibility(ctx context.Context, body PostV1CompatibilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1CompatibilityRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Name(ctx context.Context, params *GetV1NameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1NameRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1NameByValueName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1NameByValueNameRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1NameRandom(ctx context.Context, params *GetV1NameRandomParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1NameRandomRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1NameSearch(ctx context.Context, params *GetV1NameSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1NameSearchRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1NameId(ctx context.Context, id int64, params *GetV1NameIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1NameIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1NameIdNamedays(ctx context.Context, id int64, params *GetV1NameIdNamedaysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1NameIdNamedaysRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1NameIdSimilar(ctx context.Context, id int64, params *GetV1NameIdSimilarParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1NameIdSimilarRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1NameIdVariants(ctx context.Context, id int64, params *GetV1NameIdVariantsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1NameIdVariantsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Namedays(ctx context.Context, params *GetV1NamedaysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1NamedaysRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1SessionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1SessionsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1Sessions(ctx context.Context, body PostV1SessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1SessionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1SessionsId(ctx context.Context, id SessionId, params *GetV1SessionsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1SessionsIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1SessionsIdMatches(ctx context.Context, id SessionId, params *GetV1SessionsIdMatchesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1SessionsIdMatchesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1SessionsIdMatchesStream(ctx context.Context, id SessionId, params *GetV1SessionsIdMatchesStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1SessionsIdMatchesStreamRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1SessionsIdNext(ctx context.Context, id SessionId, params *GetV1SessionsIdNextParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1SessionsIdNextRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1SessionsIdVotesWithBody(ctx context.Context, id SessionId, params *PostV1SessionsIdVotesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1SessionsIdVotesRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1SessionsIdVotes(ctx context.Context, id SessionId, params *PostV1SessionsIdVotesParams, body PostV1SessionsIdVotesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1SessionsIdVotesRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1Shortlists(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ShortlistsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1ShortlistsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ShortlistsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1ShortlistsIdNamesWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ShortlistsIdNamesRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1ShortlistsIdNames(ctx context.Context, id string, body PostV1ShortlistsIdNamesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ShortlistsIdNamesRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1ShortlistsIdNamesName(ctx context.Context, id string, name string, params *DeleteV1ShortlistsIdNamesNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1ShortlistsIdNamesNameRequest(c.Server, id, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1TournamentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TournamentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1Tournament(ctx context.Context, body PostV1TournamentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TournamentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1TournamentId(ctx context.Context, id TournamentId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TournamentIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1TournamentIdLeaderboard(ctx context.Context, id TournamentId, params *GetV1TournamentIdLeaderboardParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TournamentIdLeaderboardRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1TournamentIdPair(ctx context.Context, id TournamentId, params *GetV1TournamentIdPairParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TournamentIdPairRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1TournamentIdVoteWithBody(ctx context.Context, id TournamentId, params *PostV1TournamentIdVoteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TournamentIdVoteRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1TournamentIdVote(ctx context.Context, id TournamentId, params *PostV1TournamentIdVoteParams, body PostV1TournamentIdVoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1TournamentIdVoteRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Trends(ctx context.Context, params *GetV1TrendsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1TrendsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewPostV1CompatibilityRequest calls the generic PostV1Compatibility builder with application/json body
+func NewPostV1CompatibilityRequest(server string, body PostV1CompatibilityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1CompatibilityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1CompatibilityRequestWithBody generates requests for PostV1Compatibility with any type of body
+func NewPostV1CompatibilityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/compatibility")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1NameRequest generates requests for GetV1Name
+func NewGetV1NameRequest(server string, params *GetV1NameParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/name")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Year != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Gender != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "gender", runtime.ParamLocationQuery, *params.Gender); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Cursor != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MinLength != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minLength", runtime.ParamLocationQuery, *params.MinLength); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxLength != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxLength", runtime.ParamLocationQuery, *params.MaxLength); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MinSyllables != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minSyllables", runtime.ParamLocationQuery, *params.MinSyllables); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxSyllables != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxSyllables", runtime.ParamLocationQuery, *params.MaxSyllables); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Initial != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "initial", runtime.ParamLocationQuery, *params.Initial); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Ending != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ending", runtime.ParamLocationQuery, *params.Ending); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Origin != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "origin", runtime.ParamLocationQuery, *params.Origin); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Order != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1NameByValueNameRequest generates requests for GetV1NameByValueName
+func NewGetV1NameByValueNameRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/name/by-value/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1NameRandomRequest generates requests for GetV1NameRandom
+func NewGetV1NameRandomRequest(server string, params *GetV1NameRandomParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/name/random")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Year != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Gender != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "gender", runtime.ParamLocationQuery, *params.Gender); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Weighted != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "weighted", runtime.ParamLocationQuery, *params.Weighted); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Seed != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "seed", runtime.ParamLocationQuery, *params.Seed); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Exclude != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1NameSearchRequest generates requests for GetV1NameSearch
+func NewGetV1NameSearchRequest(server string, params *GetV1NameSearchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/name/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Year != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Variants != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "variants", runtime.ParamLocationQuery, *params.Variants); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1NameIdRequest generates requests for GetV1NameId
+func NewGetV1NameIdRequest(server string, id int64, params *GetV1NameIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/name/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Year != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1NameIdNamedaysRequest generates requests for GetV1NameIdNamedays
+func NewGetV1NameIdNamedaysRequest(server string, id int64, params *GetV1NameIdNamedaysParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/name/%s/namedays", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Year != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1NameIdSimilarRequest generates requests for GetV1NameIdSimilar
+func NewGetV1NameIdSimilarRequest(server string, id int64, params *GetV1NameIdSimilarParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/name/%s/similar", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Year != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1NameIdVariantsRequest generates requests for GetV1NameIdVariants
+func NewGetV1NameIdVariantsRequest(server string, id int64, params *GetV1NameIdVariantsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/name/%s/variants", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Year != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1NamedaysRequest generates requests for GetV1Namedays
+func NewGetV1NamedaysRequest(server string, params *GetV1NamedaysParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/namedays")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Date != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, *params.Date); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Year != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1SessionsRequest calls the generic PostV1Sessions builder with application/json body
+func NewPostV1SessionsRequest(server string, body PostV1SessionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1SessionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1SessionsRequestWithBody generates requests for PostV1Sessions with any type of body
+func NewPostV1SessionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1SessionsIdRequest generates requests for GetV1SessionsId
+func NewGetV1SessionsIdRequest(server string, id SessionId, params *GetV1SessionsIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1SessionsIdMatchesRequest generates requests for GetV1SessionsIdMatches
+func NewGetV1SessionsIdMatchesRequest(server string, id SessionId, params *GetV1SessionsIdMatchesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/%s/matches", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1SessionsIdMatchesStreamRequest generates requests for GetV1SessionsIdMatchesStream
+func NewGetV1SessionsIdMatchesStreamRequest(server string, id SessionId, params *GetV1SessionsIdMatchesStreamParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/%s/matches/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1SessionsIdNextRequest generates requests for GetV1SessionsIdNext
+func NewGetV1SessionsIdNextRequest(server string, id SessionId, params *GetV1SessionsIdNextParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/%s/next", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1SessionsIdVotesRequest calls the generic PostV1SessionsIdVotes builder with application/json body
+func NewPostV1SessionsIdVotesRequest(server string, id SessionId, params *PostV1SessionsIdVotesParams, body PostV1SessionsIdVotesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1SessionsIdVotesRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostV1SessionsIdVotesRequestWithBody generates requests for PostV1SessionsIdVotes with any type of body
+func NewPostV1SessionsIdVotesRequestWithBody(server string, id SessionId, params *PostV1SessionsIdVotesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/%s/votes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostV1ShortlistsRequest generates requests for PostV1Shortlists
+func NewPostV1ShortlistsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/shortlists")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1ShortlistsIdRequest generates requests for GetV1ShortlistsId
+func NewGetV1ShortlistsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/shortlists/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1ShortlistsIdNamesRequest calls the generic PostV1ShortlistsIdNames builder with application/json body
+func NewPostV1ShortlistsIdNamesRequest(server string, id string, body PostV1ShortlistsIdNamesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1ShortlistsIdNamesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostV1ShortlistsIdNamesRequestWithBody generates requests for PostV1ShortlistsIdNames with any type of body
+func NewPostV1ShortlistsIdNamesRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/shortlists/%s/names", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1ShortlistsIdNamesNameRequest generates requests for DeleteV1ShortlistsIdNamesName
+func NewDeleteV1ShortlistsIdNamesNameRequest(server string, id string, name string, params *DeleteV1ShortlistsIdNamesNameParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/shortlists/%s/names/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Gender != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "gender", runtime.ParamLocationQuery, *params.Gender); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1TournamentRequest calls the generic PostV1Tournament builder with application/json body
+func NewPostV1TournamentRequest(server string, body PostV1TournamentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1TournamentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1TournamentRequestWithBody generates requests for PostV1Tournament with any type of body
+func NewPostV1TournamentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tournament")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1TournamentIdRequest generates requests for GetV1TournamentId
+func NewGetV1TournamentIdRequest(server string, id TournamentId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tournament/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1TournamentIdLeaderboardRequest generates requests for GetV1TournamentIdLeaderboard
+func NewGetV1TournamentIdLeaderboardRequest(server string, id TournamentId, params *GetV1TournamentIdLeaderboardParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tournament/%s/leaderboard", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Participant != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "participant", runtime.ParamLocationQuery, *params.Participant); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1TournamentIdPairRequest generates requests for GetV1TournamentIdPair
+func NewGetV1TournamentIdPairRequest(server string, id TournamentId, params *GetV1TournamentIdPairParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tournament/%s/pair", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "participant", runtime.ParamLocationQuery, params.Participant); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1TournamentIdVoteRequest calls the generic PostV1TournamentIdVote builder with application/json body
+func NewPostV1TournamentIdVoteRequest(server string, id TournamentId, params *PostV1TournamentIdVoteParams, body PostV1TournamentIdVoteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1TournamentIdVoteRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostV1TournamentIdVoteRequestWithBody generates requests for PostV1TournamentIdVote with any type of body
+func NewPostV1TournamentIdVoteRequestWithBody(server string, id TournamentId, params *PostV1TournamentIdVoteParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tournament/%s/vote", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "participant", runtime.ParamLocationQuery, params.Participant); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1TrendsRequest generates requests for GetV1Trends
+func NewGetV1TrendsRequest(server string, params *GetV1TrendsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/trends")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.By != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "by", runtime.ParamLocationQuery, *params.By); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostV1Compatibility request with any body
+	PostV1CompatibilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1CompatibilityResponse, error)
+
+	PostV1CompatibilityWithResponse(ctx context.Context, body PostV1CompatibilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1CompatibilityResponse, error)
+
+	// GetV1Name request
+	GetV1NameWithResponse(ctx context.Context, params *GetV1NameParams, reqEditors ...RequestEditorFn) (*GetV1NameResponse, error)
+
+	// GetV1NameByValueName request
+	GetV1NameByValueNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetV1NameByValueNameResponse, error)
+
+	// GetV1NameRandom request
+	GetV1NameRandomWithResponse(ctx context.Context, params *GetV1NameRandomParams, reqEditors ...RequestEditorFn) (*GetV1NameRandomResponse, error)
+
+	// GetV1NameSearch request
+	GetV1NameSearchWithResponse(ctx context.Context, params *GetV1NameSearchParams, reqEditors ...RequestEditorFn) (*GetV1NameSearchResponse, error)
+
+	// GetV1NameId request
+	GetV1NameIdWithResponse(ctx context.Context, id int64, params *GetV1NameIdParams, reqEditors ...RequestEditorFn) (*GetV1NameIdResponse, error)
+
+	// GetV1NameIdNamedays request
+	GetV1NameIdNamedaysWithResponse(ctx context.Context, id int64, params *GetV1NameIdNamedaysParams, reqEditors ...RequestEditorFn) (*GetV1NameIdNamedaysResponse, error)
+
+	// GetV1NameIdSimilar request
+	GetV1NameIdSimilarWithResponse(ctx context.Context, id int64, params *GetV1NameIdSimilarParams, reqEditors ...RequestEditorFn) (*GetV1NameIdSimilarResponse, error)
+
+	// GetV1NameIdVariants request
+	GetV1NameIdVariantsWithResponse(ctx context.Context, id int64, params *GetV1NameIdVariantsParams, reqEditors ...RequestEditorFn) (*GetV1NameIdVariantsResponse, error)
+
+	// GetV1Namedays request
+	GetV1NamedaysWithResponse(ctx context.Context, params *GetV1NamedaysParams, reqEditors ...RequestEditorFn) (*GetV1NamedaysResponse, error)
+
+	// PostV1Sessions request with any body
+	PostV1SessionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1SessionsResponse, error)
+
+	PostV1SessionsWithResponse(ctx context.Context, body PostV1SessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1SessionsResponse, error)
+
+	// GetV1SessionsId request
+	GetV1SessionsIdWithResponse(ctx context.Context, id SessionId, params *GetV1SessionsIdParams, reqEditors ...RequestEditorFn) (*GetV1SessionsIdResponse, error)
+
+	// GetV1SessionsIdMatches request
+	GetV1SessionsIdMatchesWithResponse(ctx context.Context, id SessionId, params *GetV1SessionsIdMatchesParams, reqEditors ...RequestEditorFn) (*GetV1SessionsIdMatchesResponse, error)
+
+	// GetV1SessionsIdMatchesStream request
+	GetV1SessionsIdMatchesStreamWithResponse(ctx context.Context, id SessionId, params *GetV1SessionsIdMatchesStreamParams, reqEditors ...RequestEditorFn) (*GetV1SessionsIdMatchesStreamResponse, error)
+
+	// GetV1SessionsIdNext request
+	GetV1SessionsIdNextWithResponse(ctx context.Context, id SessionId, params *GetV1SessionsIdNextParams, reqEditors ...RequestEditorFn) (*GetV1SessionsIdNextResponse, error)
+
+	// PostV1SessionsIdVotes request with any body
+	PostV1SessionsIdVotesWithBodyWithResponse(ctx context.Context, id SessionId, params *PostV1SessionsIdVotesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1SessionsIdVotesResponse, error)
+
+	PostV1SessionsIdVotesWithResponse(ctx context.Context, id SessionId, params *PostV1SessionsIdVotesParams, body PostV1SessionsIdVotesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1SessionsIdVotesResponse, error)
+
+	// PostV1Shortlists request
+	PostV1ShortlistsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1ShortlistsResponse, error)
+
+	// GetV1ShortlistsId request
+	GetV1ShortlistsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1ShortlistsIdResponse, error)
+
+	// PostV1ShortlistsIdNames request with any body
+	PostV1ShortlistsIdNamesWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ShortlistsIdNamesResponse, error)
+
+	PostV1ShortlistsIdNamesWithResponse(ctx context.Context, id string, body PostV1ShortlistsIdNamesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1ShortlistsIdNamesResponse, error)
+
+	// DeleteV1ShortlistsIdNamesName request
+	DeleteV1ShortlistsIdNamesNameWithResponse(ctx context.Context, id string, name string, params *DeleteV1ShortlistsIdNamesNameParams, reqEditors ...RequestEditorFn) (*DeleteV1ShortlistsIdNamesNameResponse, error)
+
+	// PostV1Tournament request with any body
+	PostV1TournamentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TournamentResponse, error)
+
+	PostV1TournamentWithResponse(ctx context.Context, body PostV1TournamentJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TournamentResponse, error)
+
+	// GetV1TournamentId request
+	GetV1TournamentIdWithResponse(ctx context.Context, id TournamentId, reqEditors ...RequestEditorFn) (*GetV1TournamentIdResponse, error)
+
+	// GetV1TournamentIdLeaderboard request
+	GetV1TournamentIdLeaderboardWithResponse(ctx context.Context, id TournamentId, params *GetV1TournamentIdLeaderboardParams, reqEditors ...RequestEditorFn) (*GetV1TournamentIdLeaderboardResponse, error)
+
+	// GetV1TournamentIdPair request
+	GetV1TournamentIdPairWithResponse(ctx context.Context, id TournamentId, params *GetV1TournamentIdPairParams, reqEditors ...RequestEditorFn) (*GetV1TournamentIdPairResponse, error)
+
+	// PostV1TournamentIdVote request with any body
+	PostV1TournamentIdVoteWithBodyWithResponse(ctx context.Context, id TournamentId, params *PostV1TournamentIdVoteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TournamentIdVoteResponse, error)
+
+	PostV1TournamentIdVoteWithResponse(ctx context.Context, id TournamentId, params *PostV1TournamentIdVoteParams, body PostV1TournamentIdVoteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TournamentIdVoteResponse, error)
+
+	// GetV1Trends request
+	GetV1TrendsWithResponse(ctx context.Context, params *GetV1TrendsParams, reqEditors ...RequestEditorFn) (*GetV1TrendsResponse, error)
+}
+
+type PostV1CompatibilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompatibilityResponse
+	JSON400      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1CompatibilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1CompatibilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1NameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamesPageResponse
+	JSON400      *Problem
+	JSON422      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1NameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1NameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1NameByValueNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NameHistoryResponse
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1NameByValueNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1NameByValueNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1NameRandomResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamesRandomResponse
+	JSON400      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1NameRandomResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1NameRandomResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1NameSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamesSearchResponse
+	JSON400      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1NameSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1NameSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1NameIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NameEntry
+	JSON400      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1NameIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1NameIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1NameIdNamedaysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NameDaysResponse
+	JSON400      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1NameIdNamedaysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1NameIdNamedaysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1NameIdSimilarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SimilarNamesResponse
+	JSON400      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1NameIdSimilarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1NameIdSimilarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1NameIdVariantsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NameVariantsResponse
+	JSON400      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1NameIdVariantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1NameIdVariantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1NamedaysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NameDaysOnResponse
+	JSON400      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1NamedaysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1NamedaysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1SessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SessionCreated
+	JSON400      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1SessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1SessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1SessionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Session
+	JSON403      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1SessionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1SessionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1SessionsIdMatchesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MatchesResponse
+	JSON403      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1SessionsIdMatchesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1SessionsIdMatchesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1SessionsIdMatchesStreamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1SessionsIdMatchesStreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1SessionsIdMatchesStreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1SessionsIdNextResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionNextResponse
+	JSON403      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1SessionsIdNextResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1SessionsIdNextResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1SessionsIdVotesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VoteResponse
+	JSON400      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1SessionsIdVotesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1SessionsIdVotesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1ShortlistsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Shortlist
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ShortlistsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ShortlistsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ShortlistsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Shortlist
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ShortlistsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ShortlistsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1ShortlistsIdNamesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Shortlist
+	JSON400      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ShortlistsIdNamesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ShortlistsIdNamesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteV1ShortlistsIdNamesNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Shortlist
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1ShortlistsIdNamesNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1ShortlistsIdNamesNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TournamentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Tournament
+	JSON400      *Problem
+	JSON404      *Problem
+	JSON422      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TournamentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TournamentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TournamentIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tournament
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TournamentIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TournamentIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TournamentIdLeaderboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LeaderboardResponse
+	JSON400      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TournamentIdLeaderboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TournamentIdLeaderboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TournamentIdPairResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TournamentPair
+	JSON400      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TournamentIdPairResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TournamentIdPairResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1TournamentIdVoteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TournamentPair
+	JSON400      *Problem
+	JSON404      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1TournamentIdVoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1TournamentIdVoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1TrendsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrendsResponse
+	JSON400      *Problem
+	JSON422      *Problem
+	JSONDefault  *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1TrendsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1TrendsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostV1CompatibilityWithBodyWithResponse request with arbitrary body returning *PostV1CompatibilityResponse
+func (c *ClientWithResponses) PostV1CompatibilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1CompatibilityResponse, error) {
+	rsp, err := c.PostV1CompatibilityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1CompatibilityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1CompatibilityWithResponse(ctx context.Context, body PostV1CompatibilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1CompatibilityResponse, error) {
+	rsp, err := c.PostV1Compatibility(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1CompatibilityResponse(rsp)
+}
+
+// GetV1NameWithResponse request returning *GetV1NameResponse
+func (c *ClientWithResponses) GetV1NameWithResponse(ctx context.Context, params *GetV1NameParams, reqEditors ...RequestEditorFn) (*GetV1NameResponse, error) {
+	rsp, err := c.GetV1Name(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1NameResponse(rsp)
+}
+
+// GetV1NameByValueNameWithResponse request returning *GetV1NameByValueNameResponse
+func (c *ClientWithResponses) GetV1NameByValueNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetV1NameByValueNameResponse, error) {
+	rsp, err := c.GetV1NameByValueName(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1NameByValueNameResponse(rsp)
+}
+
+// GetV1NameRandomWithResponse request returning *GetV1NameRandomResponse
+func (c *ClientWithResponses) GetV1NameRandomWithResponse(ctx context.Context, params *GetV1NameRandomParams, reqEditors ...RequestEditorFn) (*GetV1NameRandomResponse, error) {
+	rsp, err := c.GetV1NameRandom(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1NameRandomResponse(rsp)
+}
+
+// GetV1NameSearchWithResponse request returning *GetV1NameSearchResponse
+func (c *ClientWithResponses) GetV1NameSearchWithResponse(ctx context.Context, params *GetV1NameSearchParams, reqEditors ...RequestEditorFn) (*GetV1NameSearchResponse, error) {
+	rsp, err := c.GetV1NameSearch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1NameSearchResponse(rsp)
+}
+
+// GetV1NameIdWithResponse request returning *GetV1NameIdResponse
+func (c *ClientWithResponses) GetV1NameIdWithResponse(ctx context.Context, id int64, params *GetV1NameIdParams, reqEditors ...RequestEditorFn) (*GetV1NameIdResponse, error) {
+	rsp, err := c.GetV1NameId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1NameIdResponse(rsp)
+}
+
+// GetV1NameIdNamedaysWithResponse request returning *GetV1NameIdNamedaysResponse
+func (c *ClientWithResponses) GetV1NameIdNamedaysWithResponse(ctx context.Context, id int64, params *GetV1NameIdNamedaysParams, reqEditors ...RequestEditorFn) (*GetV1NameIdNamedaysResponse, error) {
+	rsp, err := c.GetV1NameIdNamedays(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1NameIdNamedaysResponse(rsp)
+}
+
+// GetV1NameIdSimilarWithResponse request returning *GetV1NameIdSimilarResponse
+func (c *ClientWithResponses) GetV1NameIdSimilarWithResponse(ctx context.Context, id int64, params *GetV1NameIdSimilarParams, reqEditors ...RequestEditorFn) (*GetV1NameIdSimilarResponse, error) {
+	rsp, err := c.GetV1NameIdSimilar(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1NameIdSimilarResponse(rsp)
+}
+
+// GetV1NameIdVariantsWithResponse request returning *GetV1NameIdVariantsResponse
+func (c *ClientWithResponses) GetV1NameIdVariantsWithResponse(ctx context.Context, id int64, params *GetV1NameIdVariantsParams, reqEditors ...RequestEditorFn) (*GetV1NameIdVariantsResponse, error) {
+	rsp, err := c.GetV1NameIdVariants(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1NameIdVariantsResponse(rsp)
+}
+
+// GetV1NamedaysWithResponse request returning *GetV1NamedaysResponse
+func (c *ClientWithResponses) GetV1NamedaysWithResponse(ctx context.Context, params *GetV1NamedaysParams, reqEditors ...RequestEditorFn) (*GetV1NamedaysResponse, error) {
+	rsp, err := c.GetV1Namedays(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1NamedaysResponse(rsp)
+}
+
+// PostV1SessionsWithBodyWithResponse request with arbitrary body returning *PostV1SessionsResponse
+func (c *ClientWithResponses) PostV1SessionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1SessionsResponse, error) {
+	rsp, err := c.PostV1SessionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1SessionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1SessionsWithResponse(ctx context.Context, body PostV1SessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1SessionsResponse, error) {
+	rsp, err := c.PostV1Sessions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1SessionsResponse(rsp)
+}
+
+// GetV1SessionsIdWithResponse request returning *GetV1SessionsIdResponse
+func (c *ClientWithResponses) GetV1SessionsIdWithResponse(ctx context.Context, id SessionId, params *GetV1SessionsIdParams, reqEditors ...RequestEditorFn) (*GetV1SessionsIdResponse, error) {
+	rsp, err := c.GetV1SessionsId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1SessionsIdResponse(rsp)
+}
+
+// GetV1SessionsIdMatchesWithResponse request returning *GetV1SessionsIdMatchesResponse
+func (c *ClientWithResponses) GetV1SessionsIdMatchesWithResponse(ctx context.Context, id SessionId, params *GetV1SessionsIdMatchesParams, reqEditors ...RequestEditorFn) (*GetV1SessionsIdMatchesResponse, error) {
+	rsp, err := c.GetV1SessionsIdMatches(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1SessionsIdMatchesResponse(rsp)
+}
+
+// GetV1SessionsIdMatchesStreamWithResponse request returning *GetV1SessionsIdMatchesStreamResponse
+func (c *ClientWithResponses) GetV1SessionsIdMatchesStreamWithResponse(ctx context.Context, id SessionId, params *GetV1SessionsIdMatchesStreamParams, reqEditors ...RequestEditorFn) (*GetV1SessionsIdMatchesStreamResponse, error) {
+	rsp, err := c.GetV1SessionsIdMatchesStream(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1SessionsIdMatchesStreamResponse(rsp)
+}
+
+// GetV1SessionsIdNextWithResponse request returning *GetV1SessionsIdNextResponse
+func (c *ClientWithResponses) GetV1SessionsIdNextWithResponse(ctx context.Context, id SessionId, params *GetV1SessionsIdNextParams, reqEditors ...RequestEditorFn) (*GetV1SessionsIdNextResponse, error) {
+	rsp, err := c.GetV1SessionsIdNext(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1SessionsIdNextResponse(rsp)
+}
+
+// PostV1SessionsIdVotesWithBodyWithResponse request with arbitrary body returning *PostV1SessionsIdVotesResponse
+func (c *ClientWithResponses) PostV1SessionsIdVotesWithBodyWithResponse(ctx context.Context, id SessionId, params *PostV1SessionsIdVotesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1SessionsIdVotesResponse, error) {
+	rsp, err := c.PostV1SessionsIdVotesWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1SessionsIdVotesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1SessionsIdVotesWithResponse(ctx context.Context, id SessionId, params *PostV1SessionsIdVotesParams, body PostV1SessionsIdVotesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1SessionsIdVotesResponse, error) {
+	rsp, err := c.PostV1SessionsIdVotes(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1SessionsIdVotesResponse(rsp)
+}
+
+// PostV1ShortlistsWithResponse request returning *PostV1ShortlistsResponse
+func (c *ClientWithResponses) PostV1ShortlistsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1ShortlistsResponse, error) {
+	rsp, err := c.PostV1Shortlists(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ShortlistsResponse(rsp)
+}
+
+// GetV1ShortlistsIdWithResponse request returning *GetV1ShortlistsIdResponse
+func (c *ClientWithResponses) GetV1ShortlistsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1ShortlistsIdResponse, error) {
+	rsp, err := c.GetV1ShortlistsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ShortlistsIdResponse(rsp)
+}
+
+// PostV1ShortlistsIdNamesWithBodyWithResponse request with arbitrary body returning *PostV1ShortlistsIdNamesResponse
+func (c *ClientWithResponses) PostV1ShortlistsIdNamesWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ShortlistsIdNamesResponse, error) {
+	rsp, err := c.PostV1ShortlistsIdNamesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ShortlistsIdNamesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1ShortlistsIdNamesWithResponse(ctx context.Context, id string, body PostV1ShortlistsIdNamesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1ShortlistsIdNamesResponse, error) {
+	rsp, err := c.PostV1ShortlistsIdNames(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ShortlistsIdNamesResponse(rsp)
+}
+
+// DeleteV1ShortlistsIdNamesNameWithResponse request returning *DeleteV1ShortlistsIdNamesNameResponse
+func (c *ClientWithResponses) DeleteV1ShortlistsIdNamesNameWithResponse(ctx context.Context, id string, name string, params *DeleteV1ShortlistsIdNamesNameParams, reqEditors ...RequestEditorFn) (*DeleteV1ShortlistsIdNamesNameResponse, error) {
+	rsp, err := c.DeleteV1ShortlistsIdNamesName(ctx, id, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1ShortlistsIdNamesNameResponse(rsp)
+}
+
+// PostV1TournamentWithBodyWithResponse request with arbitrary body returning *PostV1TournamentResponse
+func (c *ClientWithResponses) PostV1TournamentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TournamentResponse, error) {
+	rsp, err := c.PostV1TournamentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TournamentResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1TournamentWithResponse(ctx context.Context, body PostV1TournamentJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TournamentResponse, error) {
+	rsp, err := c.PostV1Tournament(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TournamentResponse(rsp)
+}
+
+// GetV1TournamentIdWithResponse request returning *GetV1TournamentIdResponse
+func (c *ClientWithResponses) GetV1TournamentIdWithResponse(ctx context.Context, id TournamentId, reqEditors ...RequestEditorFn) (*GetV1TournamentIdResponse, error) {
+	rsp, err := c.GetV1TournamentId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TournamentIdResponse(rsp)
+}
+
+// GetV1TournamentIdLeaderboardWithResponse request returning *GetV1TournamentIdLeaderboardResponse
+func (c *ClientWithResponses) GetV1TournamentIdLeaderboardWithResponse(ctx context.Context, id TournamentId, params *GetV1TournamentIdLeaderboardParams, reqEditors ...RequestEditorFn) (*GetV1TournamentIdLeaderboardResponse, error) {
+	rsp, err := c.GetV1TournamentIdLeaderboard(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TournamentIdLeaderboardResponse(rsp)
+}
+
+// GetV1TournamentIdPairWithResponse request returning *GetV1TournamentIdPairResponse
+func (c *ClientWithResponses) GetV1TournamentIdPairWithResponse(ctx context.Context, id TournamentId, params *GetV1TournamentIdPairParams, reqEditors ...RequestEditorFn) (*GetV1TournamentIdPairResponse, error) {
+	rsp, err := c.GetV1TournamentIdPair(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TournamentIdPairResponse(rsp)
+}
+
+// PostV1TournamentIdVoteWithBodyWithResponse request with arbitrary body returning *PostV1TournamentIdVoteResponse
+func (c *ClientWithResponses) PostV1TournamentIdVoteWithBodyWithResponse(ctx context.Context, id TournamentId, params *PostV1TournamentIdVoteParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1TournamentIdVoteResponse, error) {
+	rsp, err := c.PostV1TournamentIdVoteWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TournamentIdVoteResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1TournamentIdVoteWithResponse(ctx context.Context, id TournamentId, params *PostV1TournamentIdVoteParams, body PostV1TournamentIdVoteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1TournamentIdVoteResponse, error) {
+	rsp, err := c.PostV1TournamentIdVote(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1TournamentIdVoteResponse(rsp)
+}
+
+// GetV1TrendsWithResponse request returning *GetV1TrendsResponse
+func (c *ClientWithResponses) GetV1TrendsWithResponse(ctx context.Context, params *GetV1TrendsParams, reqEditors ...RequestEditorFn) (*GetV1TrendsResponse, error) {
+	rsp, err := c.GetV1Trends(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1TrendsResponse(rsp)
+}
+
+// ParsePostV1CompatibilityResponse parses an HTTP response from a PostV1CompatibilityWithResponse call
+func ParsePostV1CompatibilityResponse(rsp *http.Response) (*PostV1CompatibilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1CompatibilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompatibilityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1NameResponse parses an HTTP response from a GetV1NameWithResponse call
+func ParseGetV1NameResponse(rsp *http.Response) (*GetV1NameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1NameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamesPageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 422:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1NameByValueNameResponse parses an HTTP response from a GetV1NameByValueNameWithResponse call
+func ParseGetV1NameByValueNameResponse(rsp *http.Response) (*GetV1NameByValueNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1NameByValueNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NameHistoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1NameRandomResponse parses an HTTP response from a GetV1NameRandomWithResponse call
+func ParseGetV1NameRandomResponse(rsp *http.Response) (*GetV1NameRandomResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1NameRandomResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamesRandomResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1NameSearchResponse parses an HTTP response from a GetV1NameSearchWithResponse call
+func ParseGetV1NameSearchResponse(rsp *http.Response) (*GetV1NameSearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1NameSearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamesSearchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1NameIdResponse parses an HTTP response from a GetV1NameIdWithResponse call
+func ParseGetV1NameIdResponse(rsp *http.Response) (*GetV1NameIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1NameIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NameEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1NameIdNamedaysResponse parses an HTTP response from a GetV1NameIdNamedaysWithResponse call
+func ParseGetV1NameIdNamedaysResponse(rsp *http.Response) (*GetV1NameIdNamedaysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1NameIdNamedaysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NameDaysResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1NameIdSimilarResponse parses an HTTP response from a GetV1NameIdSimilarWithResponse call
+func ParseGetV1NameIdSimilarResponse(rsp *http.Response) (*GetV1NameIdSimilarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1NameIdSimilarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SimilarNamesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1NameIdVariantsResponse parses an HTTP response from a GetV1NameIdVariantsWithResponse call
+func ParseGetV1NameIdVariantsResponse(rsp *http.Response) (*GetV1NameIdVariantsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1NameIdVariantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NameVariantsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1NamedaysResponse parses an HTTP response from a GetV1NamedaysWithResponse call
+func ParseGetV1NamedaysResponse(rsp *http.Response) (*GetV1NamedaysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1NamedaysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NameDaysOnResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1SessionsResponse parses an HTTP response from a PostV1SessionsWithResponse call
+func ParsePostV1SessionsResponse(rsp *http.Response) (*PostV1SessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1SessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SessionCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1SessionsIdResponse parses an HTTP response from a GetV1SessionsIdWithResponse call
+func ParseGetV1SessionsIdResponse(rsp *http.Response) (*GetV1SessionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1SessionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Session
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 403:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1SessionsIdMatchesResponse parses an HTTP response from a GetV1SessionsIdMatchesWithResponse call
+func ParseGetV1SessionsIdMatchesResponse(rsp *http.Response) (*GetV1SessionsIdMatchesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1SessionsIdMatchesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MatchesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 403:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1SessionsIdMatchesStreamResponse parses an HTTP response from a GetV1SessionsIdMatchesStreamWithResponse call
+func ParseGetV1SessionsIdMatchesStreamResponse(rsp *http.Response) (*GetV1SessionsIdMatchesStreamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1SessionsIdMatchesStreamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 403:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1SessionsIdNextResponse parses an HTTP response from a GetV1SessionsIdNextWithResponse call
+func ParseGetV1SessionsIdNextResponse(rsp *http.Response) (*GetV1SessionsIdNextResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1SessionsIdNextResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionNextResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 403:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1SessionsIdVotesResponse parses an HTTP response from a PostV1SessionsIdVotesWithResponse call
+func ParsePostV1SessionsIdVotesResponse(rsp *http.Response) (*PostV1SessionsIdVotesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1SessionsIdVotesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VoteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 403:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1ShortlistsResponse parses an HTTP response from a PostV1ShortlistsWithResponse call
+func ParsePostV1ShortlistsResponse(rsp *http.Response) (*PostV1ShortlistsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ShortlistsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Shortlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ShortlistsIdResponse parses an HTTP response from a GetV1ShortlistsIdWithResponse call
+func ParseGetV1ShortlistsIdResponse(rsp *http.Response) (*GetV1ShortlistsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ShortlistsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Shortlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1ShortlistsIdNamesResponse parses an HTTP response from a PostV1ShortlistsIdNamesWithResponse call
+func ParsePostV1ShortlistsIdNamesResponse(rsp *http.Response) (*PostV1ShortlistsIdNamesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ShortlistsIdNamesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Shortlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1ShortlistsIdNamesNameResponse parses an HTTP response from a DeleteV1ShortlistsIdNamesNameWithResponse call
+func ParseDeleteV1ShortlistsIdNamesNameResponse(rsp *http.Response) (*DeleteV1ShortlistsIdNamesNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1ShortlistsIdNamesNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Shortlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1TournamentResponse parses an HTTP response from a PostV1TournamentWithResponse call
+func ParsePostV1TournamentResponse(rsp *http.Response) (*PostV1TournamentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1TournamentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Tournament
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 422:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1TournamentIdResponse parses an HTTP response from a GetV1TournamentIdWithResponse call
+func ParseGetV1TournamentIdResponse(rsp *http.Response) (*GetV1TournamentIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1TournamentIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tournament
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1TournamentIdLeaderboardResponse parses an HTTP response from a GetV1TournamentIdLeaderboardWithResponse call
+func ParseGetV1TournamentIdLeaderboardResponse(rsp *http.Response) (*GetV1TournamentIdLeaderboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1TournamentIdLeaderboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LeaderboardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1TournamentIdPairResponse parses an HTTP response from a GetV1TournamentIdPairWithResponse call
+func ParseGetV1TournamentIdPairResponse(rsp *http.Response) (*GetV1TournamentIdPairResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1TournamentIdPairResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TournamentPair
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1TournamentIdVoteResponse parses an HTTP response from a PostV1TournamentIdVoteWithResponse call
+func ParsePostV1TournamentIdVoteResponse(rsp *http.Response) (*PostV1TournamentIdVoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1TournamentIdVoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TournamentPair
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 404:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1TrendsResponse parses an HTTP response from a GetV1TrendsWithResponse call
+func ParseGetV1TrendsResponse(rsp *http.Response) (*GetV1TrendsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1TrendsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrendsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 400:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case rsp.StatusCode == 422:
+	// Content-type (application/json; charset=UTF-8) unsupported
+
+	case true:
+		// Content-type (application/json; charset=UTF-8) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /v1/compatibility)
+	PostV1Compatibility(ctx echo.Context) error
+
+	// (GET /v1/name)
+	GetV1Name(ctx echo.Context, params GetV1NameParams) error
+
+	// (GET /v1/name/by-value/{name})
+	GetV1NameByValueName(ctx echo.Context, name string) error
+
+	// (GET /v1/name/random)
+	GetV1NameRandom(ctx echo.Context, params GetV1NameRandomParams) error
+
+	// (GET /v1/name/search)
+	GetV1NameSearch(ctx echo.Context, params GetV1NameSearchParams) error
+
+	// (GET /v1/name/{id})
+	GetV1NameId(ctx echo.Context, id int64, params GetV1NameIdParams) error
+
+	// (GET /v1/name/{id}/namedays)
+	GetV1NameIdNamedays(ctx echo.Context, id int64, params GetV1NameIdNamedaysParams) error
+
+	// (GET /v1/name/{id}/similar)
+	GetV1NameIdSimilar(ctx echo.Context, id int64, params GetV1NameIdSimilarParams) error
+
+	// (GET /v1/name/{id}/variants)
+	GetV1NameIdVariants(ctx echo.Context, id int64, params GetV1NameIdVariantsParams) error
+
+	// (GET /v1/namedays)
+	GetV1Namedays(ctx echo.Context, params GetV1NamedaysParams) error
+
+	// (POST /v1/sessions)
+	PostV1Sessions(ctx echo.Context) error
+
+	// (GET /v1/sessions/{id})
+	GetV1SessionsId(ctx echo.Context, id SessionId, params GetV1SessionsIdParams) error
+
+	// (GET /v1/sessions/{id}/matches)
+	GetV1SessionsIdMatches(ctx echo.Context, id SessionId, params GetV1SessionsIdMatchesParams) error
+
+	// (GET /v1/sessions/{id}/matches/stream)
+	GetV1SessionsIdMatchesStream(ctx echo.Context, id SessionId, params GetV1SessionsIdMatchesStreamParams) error
+
+	// (GET /v1/sessions/{id}/next)
+	GetV1SessionsIdNext(ctx echo.Context, id SessionId, params GetV1SessionsIdNextParams) error
+
+	// (POST /v1/sessions/{id}/votes)
+	PostV1SessionsIdVotes(ctx echo.Context, id SessionId, params PostV1SessionsIdVotesParams) error
+
+	// (POST /v1/shortlists)
+	PostV1Shortlists(ctx echo.Context) error
+
+	// (GET /v1/shortlists/{id})
+	GetV1ShortlistsId(ctx echo.Context, id string) error
+
+	// (POST /v1/shortlists/{id}/names)
+	PostV1ShortlistsIdNames(ctx echo.Context, id string) error
+
+	// (DELETE /v1/shortlists/{id}/names/{name})
+	DeleteV1ShortlistsIdNamesName(ctx echo.Context, id string, name string, params DeleteV1ShortlistsIdNamesNameParams) error
+
+	// (POST /v1/tournament)
+	PostV1Tournament(ctx echo.Context) error
+
+	// (GET /v1/tournament/{id})
+	GetV1TournamentId(ctx echo.Context, id TournamentId) error
+
+	// (GET /v1/tournament/{id}/leaderboard)
+	GetV1TournamentIdLeaderboard(ctx echo.Context, id TournamentId, params GetV1TournamentIdLeaderboardParams) error
+
+	// (GET /v1/tournament/{id}/pair)
+	GetV1TournamentIdPair(ctx echo.Context, id TournamentId, params GetV1TournamentIdPairParams) error
+
+	// (POST /v1/tournament/{id}/vote)
+	PostV1TournamentIdVote(ctx echo.Context, id TournamentId, params PostV1TournamentIdVoteParams) error
+
+	// (GET /v1/trends)
+	GetV1Trends(ctx echo.Context, params GetV1TrendsParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// PostV1Compatibility converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV1Compatibility(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostV1Compatibility(ctx)
+	return err
+}
+
+// GetV1Name converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1Name(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1NameParams
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year", ctx.QueryParams(), &params.Year)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "gender" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gender", ctx.QueryParams(), &params.Gender)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gender: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "minLength" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minLength", ctx.QueryParams(), &params.MinLength)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minLength: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxLength" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxLength", ctx.QueryParams(), &params.MaxLength)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxLength: %s", err))
+	}
+
+	// ------------- Optional query parameter "minSyllables" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minSyllables", ctx.QueryParams(), &params.MinSyllables)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minSyllables: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxSyllables" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxSyllables", ctx.QueryParams(), &params.MaxSyllables)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxSyllables: %s", err))
+	}
+
+	// ------------- Optional query parameter "initial" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "initial", ctx.QueryParams(), &params.Initial)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter initial: %s", err))
+	}
+
+	// ------------- Optional query parameter "ending" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ending", ctx.QueryParams(), &params.Ending)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ending: %s", err))
+	}
+
+	// ------------- Optional query parameter "origin" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "origin", ctx.QueryParams(), &params.Origin)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter origin: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1Name(ctx, params)
+	return err
+}
+
+// GetV1NameByValueName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1NameByValueName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1NameByValueName(ctx, name)
+	return err
+}
+
+// GetV1NameRandom converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1NameRandom(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1NameRandomParams
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year", ctx.QueryParams(), &params.Year)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+	}
+
+	// ------------- Optional query parameter "gender" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gender", ctx.QueryParams(), &params.Gender)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gender: %s", err))
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
+	}
+
+	// ------------- Optional query parameter "weighted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weighted", ctx.QueryParams(), &params.Weighted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter weighted: %s", err))
+	}
+
+	// ------------- Optional query parameter "seed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "seed", ctx.QueryParams(), &params.Seed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seed: %s", err))
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude", ctx.QueryParams(), &params.Exclude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1NameRandom(ctx, params)
+	return err
+}
+
+// GetV1NameSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1NameSearch(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1NameSearchParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year", ctx.QueryParams(), &params.Year)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "variants" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "variants", ctx.QueryParams(), &params.Variants)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter variants: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1NameSearch(ctx, params)
+	return err
+}
+
+// GetV1NameId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1NameId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1NameIdParams
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year", ctx.QueryParams(), &params.Year)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1NameId(ctx, id, params)
+	return err
+}
+
+// GetV1NameIdNamedays converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1NameIdNamedays(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1NameIdNamedaysParams
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year", ctx.QueryParams(), &params.Year)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1NameIdNamedays(ctx, id, params)
+	return err
+}
+
+// GetV1NameIdSimilar converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1NameIdSimilar(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1NameIdSimilarParams
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year", ctx.QueryParams(), &params.Year)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1NameIdSimilar(ctx, id, params)
+	return err
+}
+
+// GetV1NameIdVariants converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1NameIdVariants(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1NameIdVariantsParams
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year", ctx.QueryParams(), &params.Year)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1NameIdVariants(ctx, id, params)
+	return err
+}
+
+// GetV1Namedays converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1Namedays(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1NamedaysParams
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", ctx.QueryParams(), &params.Date)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date: %s", err))
+	}
+
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year", ctx.QueryParams(), &params.Year)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1Namedays(ctx, params)
+	return err
+}
+
+// PostV1Sessions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV1Sessions(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostV1Sessions(ctx)
+	return err
+}
+
+// GetV1SessionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1SessionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id SessionId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1SessionsIdParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1SessionsId(ctx, id, params)
+	return err
+}
+
+// GetV1SessionsIdMatches converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1SessionsIdMatches(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id SessionId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1SessionsIdMatchesParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1SessionsIdMatches(ctx, id, params)
+	return err
+}
+
+// GetV1SessionsIdMatchesStream converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1SessionsIdMatchesStream(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id SessionId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1SessionsIdMatchesStreamParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1SessionsIdMatchesStream(ctx, id, params)
+	return err
+}
+
+// GetV1SessionsIdNext converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1SessionsIdNext(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id SessionId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1SessionsIdNextParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1SessionsIdNext(ctx, id, params)
+	return err
+}
+
+// PostV1SessionsIdVotes converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV1SessionsIdVotes(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id SessionId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV1SessionsIdVotesParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostV1SessionsIdVotes(ctx, id, params)
+	return err
+}
+
+// PostV1Shortlists converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV1Shortlists(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostV1Shortlists(ctx)
+	return err
+}
+
+// GetV1ShortlistsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1ShortlistsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1ShortlistsId(ctx, id)
+	return err
+}
+
+// PostV1ShortlistsIdNames converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV1ShortlistsIdNames(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostV1ShortlistsIdNames(ctx, id)
+	return err
+}
+
+// DeleteV1ShortlistsIdNamesName converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteV1ShortlistsIdNamesName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteV1ShortlistsIdNamesNameParams
+	// ------------- Optional query parameter "gender" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gender", ctx.QueryParams(), &params.Gender)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gender: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteV1ShortlistsIdNamesName(ctx, id, name, params)
+	return err
+}
+
+// PostV1Tournament converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV1Tournament(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostV1Tournament(ctx)
+	return err
+}
+
+// GetV1TournamentId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1TournamentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id TournamentId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1TournamentId(ctx, id)
+	return err
+}
+
+// GetV1TournamentIdLeaderboard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1TournamentIdLeaderboard(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id TournamentId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1TournamentIdLeaderboardParams
+	// ------------- Optional query parameter "participant" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "participant", ctx.QueryParams(), &params.Participant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participant: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1TournamentIdLeaderboard(ctx, id, params)
+	return err
+}
+
+// GetV1TournamentIdPair converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1TournamentIdPair(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id TournamentId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1TournamentIdPairParams
+	// ------------- Required query parameter "participant" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "participant", ctx.QueryParams(), &params.Participant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participant: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1TournamentIdPair(ctx, id, params)
+	return err
+}
+
+// PostV1TournamentIdVote converts echo context to params.
+func (w *ServerInterfaceWrapper) PostV1TournamentIdVote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id TournamentId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV1TournamentIdVoteParams
+	// ------------- Required query parameter "participant" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "participant", ctx.QueryParams(), &params.Participant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participant: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostV1TournamentIdVote(ctx, id, params)
+	return err
+}
+
+// GetV1Trends converts echo context to params.
+func (w *ServerInterfaceWrapper) GetV1Trends(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1TrendsParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "by", ctx.QueryParams(), &params.By)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter by: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetV1Trends(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/v1/compatibility", wrapper.PostV1Compatibility)
+	router.GET(baseURL+"/v1/name", wrapper.GetV1Name)
+	router.GET(baseURL+"/v1/name/by-value/:name", wrapper.GetV1NameByValueName)
+	router.GET(baseURL+"/v1/name/random", wrapper.GetV1NameRandom)
+	router.GET(baseURL+"/v1/name/search", wrapper.GetV1NameSearch)
+	router.GET(baseURL+"/v1/name/:id", wrapper.GetV1NameId)
+	router.GET(baseURL+"/v1/name/:id/namedays", wrapper.GetV1NameIdNamedays)
+	router.GET(baseURL+"/v1/name/:id/similar", wrapper.GetV1NameIdSimilar)
+	router.GET(baseURL+"/v1/name/:id/variants", wrapper.GetV1NameIdVariants)
+	router.GET(baseURL+"/v1/namedays", wrapper.GetV1Namedays)
+	router.POST(baseURL+"/v1/sessions", wrapper.PostV1Sessions)
+	router.GET(baseURL+"/v1/sessions/:id", wrapper.GetV1SessionsId)
+	router.GET(baseURL+"/v1/sessions/:id/matches", wrapper.GetV1SessionsIdMatches)
+	router.GET(baseURL+"/v1/sessions/:id/matches/stream", wrapper.GetV1SessionsIdMatchesStream)
+	router.GET(baseURL+"/v1/sessions/:id/next", wrapper.GetV1SessionsIdNext)
+	router.POST(baseURL+"/v1/sessions/:id/votes", wrapper.PostV1SessionsIdVotes)
+	router.POST(baseURL+"/v1/shortlists", wrapper.PostV1Shortlists)
+	router.GET(baseURL+"/v1/shortlists/:id", wrapper.GetV1ShortlistsId)
+	router.POST(baseURL+"/v1/shortlists/:id/names", wrapper.PostV1ShortlistsIdNames)
+	router.DELETE(baseURL+"/v1/shortlists/:id/names/:name", wrapper.DeleteV1ShortlistsIdNamesName)
+	router.POST(baseURL+"/v1/tournament", wrapper.PostV1Tournament)
+	router.GET(baseURL+"/v1/tournament/:id", wrapper.GetV1TournamentId)
+	router.GET(baseURL+"/v1/tournament/:id/leaderboard", wrapper.GetV1TournamentIdLeaderboard)
+	router.GET(baseURL+"/v1/tournament/:id/pair", wrapper.GetV1TournamentIdPair)
+	router.POST(baseURL+"/v1/tournament/:id/vote", wrapper.PostV1TournamentIdVote)
+	router.GET(baseURL+"/v1/trends", wrapper.GetV1Trends)
+
+}
+
+type BadRequestApplicationjsonCharsetUTF8Response struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+type BadRequestJSONResponse Problem
+
+type ForbiddenApplicationjsonCharsetUTF8Response struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+type ForbiddenJSONResponse Problem
+
+type NotFoundApplicationjsonCharsetUTF8Response struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+type NotFoundJSONResponse Problem
+
+type UnexpectedErrorApplicationjsonCharsetUTF8Response struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+type UnexpectedErrorJSONResponse Problem
+
+type UnprocessableEntityApplicationjsonCharsetUTF8Response struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+type UnprocessableEntityJSONResponse Problem
+
+type PostV1CompatibilityRequestObject struct {
+	Body *PostV1CompatibilityJSONRequestBody
+}
+
+type PostV1CompatibilityResponseObject interface {
+	VisitPostV1CompatibilityResponse(w http.ResponseWriter) error
+}
+
+type PostV1Compatibility200JSONResponse CompatibilityResponse
+
+func (response PostV1Compatibility200JSONResponse) VisitPostV1CompatibilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Compatibility400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1Compatibility400ApplicationjsonCharsetUTF8Response) VisitPostV1CompatibilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1Compatibility400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostV1Compatibility400JSONResponse) VisitPostV1CompatibilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1CompatibilitydefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response PostV1CompatibilitydefaultApplicationjsonCharsetUTF8Response) VisitPostV1CompatibilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1CompatibilitydefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response PostV1CompatibilitydefaultJSONResponse) VisitPostV1CompatibilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1NameRequestObject struct {
+	Params GetV1NameParams
+}
+
+type GetV1NameResponseObject interface {
+	VisitGetV1NameResponse(w http.ResponseWriter) error
+}
+
+type GetV1Name200JSONResponse NamesPageResponse
+
+func (response GetV1Name200JSONResponse) VisitGetV1NameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1Name400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1Name400ApplicationjsonCharsetUTF8Response) VisitGetV1NameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1Name400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1Name400JSONResponse) VisitGetV1NameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1Name422ApplicationjsonCharsetUTF8Response struct {
+	UnprocessableEntityApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1Name422ApplicationjsonCharsetUTF8Response) VisitGetV1NameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(422)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1Name422JSONResponse struct {
+	UnprocessableEntityJSONResponse
+}
+
+func (response GetV1Name422JSONResponse) VisitGetV1NameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NamedefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1NamedefaultApplicationjsonCharsetUTF8Response) VisitGetV1NameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NamedefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1NamedefaultJSONResponse) VisitGetV1NameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1NameByValueNameRequestObject struct {
+	Name string `json:"name"`
+}
+
+type GetV1NameByValueNameResponseObject interface {
+	VisitGetV1NameByValueNameResponse(w http.ResponseWriter) error
+}
+
+type GetV1NameByValueName200JSONResponse NameHistoryResponse
+
+func (response GetV1NameByValueName200JSONResponse) VisitGetV1NameByValueNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameByValueName404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameByValueName404ApplicationjsonCharsetUTF8Response) VisitGetV1NameByValueNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameByValueName404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1NameByValueName404JSONResponse) VisitGetV1NameByValueNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameByValueNamedefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1NameByValueNamedefaultApplicationjsonCharsetUTF8Response) VisitGetV1NameByValueNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameByValueNamedefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1NameByValueNamedefaultJSONResponse) VisitGetV1NameByValueNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1NameRandomRequestObject struct {
+	Params GetV1NameRandomParams
+}
+
+type GetV1NameRandomResponseObject interface {
+	VisitGetV1NameRandomResponse(w http.ResponseWriter) error
+}
+
+type GetV1NameRandom200JSONResponse NamesRandomResponse
+
+func (response GetV1NameRandom200JSONResponse) VisitGetV1NameRandomResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameRandom400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameRandom400ApplicationjsonCharsetUTF8Response) VisitGetV1NameRandomResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameRandom400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1NameRandom400JSONResponse) VisitGetV1NameRandomResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameRandomdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1NameRandomdefaultApplicationjsonCharsetUTF8Response) VisitGetV1NameRandomResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameRandomdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1NameRandomdefaultJSONResponse) VisitGetV1NameRandomResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1NameSearchRequestObject struct {
+	Params GetV1NameSearchParams
+}
+
+type GetV1NameSearchResponseObject interface {
+	VisitGetV1NameSearchResponse(w http.ResponseWriter) error
+}
+
+type GetV1NameSearch200JSONResponse NamesSearchResponse
+
+func (response GetV1NameSearch200JSONResponse) VisitGetV1NameSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameSearch400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameSearch400ApplicationjsonCharsetUTF8Response) VisitGetV1NameSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameSearch400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1NameSearch400JSONResponse) VisitGetV1NameSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameSearchdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1NameSearchdefaultApplicationjsonCharsetUTF8Response) VisitGetV1NameSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameSearchdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1NameSearchdefaultJSONResponse) VisitGetV1NameSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1NameIdRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetV1NameIdParams
+}
+
+type GetV1NameIdResponseObject interface {
+	VisitGetV1NameIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1NameId200JSONResponse NameEntry
+
+func (response GetV1NameId200JSONResponse) VisitGetV1NameIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameId400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameId400ApplicationjsonCharsetUTF8Response) VisitGetV1NameIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1NameId400JSONResponse) VisitGetV1NameIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameId404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameId404ApplicationjsonCharsetUTF8Response) VisitGetV1NameIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1NameId404JSONResponse) VisitGetV1NameIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameIddefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1NameIddefaultApplicationjsonCharsetUTF8Response) VisitGetV1NameIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameIddefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1NameIddefaultJSONResponse) VisitGetV1NameIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1NameIdNamedaysRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetV1NameIdNamedaysParams
+}
+
+type GetV1NameIdNamedaysResponseObject interface {
+	VisitGetV1NameIdNamedaysResponse(w http.ResponseWriter) error
+}
+
+type GetV1NameIdNamedays200JSONResponse NameDaysResponse
+
+func (response GetV1NameIdNamedays200JSONResponse) VisitGetV1NameIdNamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameIdNamedays400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameIdNamedays400ApplicationjsonCharsetUTF8Response) VisitGetV1NameIdNamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameIdNamedays400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1NameIdNamedays400JSONResponse) VisitGetV1NameIdNamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameIdNamedays404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameIdNamedays404ApplicationjsonCharsetUTF8Response) VisitGetV1NameIdNamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameIdNamedays404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1NameIdNamedays404JSONResponse) VisitGetV1NameIdNamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameIdNamedaysdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1NameIdNamedaysdefaultApplicationjsonCharsetUTF8Response) VisitGetV1NameIdNamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameIdNamedaysdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1NameIdNamedaysdefaultJSONResponse) VisitGetV1NameIdNamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1NameIdSimilarRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetV1NameIdSimilarParams
+}
+
+type GetV1NameIdSimilarResponseObject interface {
+	VisitGetV1NameIdSimilarResponse(w http.ResponseWriter) error
+}
+
+type GetV1NameIdSimilar200JSONResponse SimilarNamesResponse
+
+func (response GetV1NameIdSimilar200JSONResponse) VisitGetV1NameIdSimilarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameIdSimilar400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameIdSimilar400ApplicationjsonCharsetUTF8Response) VisitGetV1NameIdSimilarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameIdSimilar400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1NameIdSimilar400JSONResponse) VisitGetV1NameIdSimilarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameIdSimilar404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameIdSimilar404ApplicationjsonCharsetUTF8Response) VisitGetV1NameIdSimilarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameIdSimilar404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1NameIdSimilar404JSONResponse) VisitGetV1NameIdSimilarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameIdSimilardefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1NameIdSimilardefaultApplicationjsonCharsetUTF8Response) VisitGetV1NameIdSimilarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameIdSimilardefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1NameIdSimilardefaultJSONResponse) VisitGetV1NameIdSimilarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1NameIdVariantsRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetV1NameIdVariantsParams
+}
+
+type GetV1NameIdVariantsResponseObject interface {
+	VisitGetV1NameIdVariantsResponse(w http.ResponseWriter) error
+}
+
+type GetV1NameIdVariants200JSONResponse NameVariantsResponse
+
+func (response GetV1NameIdVariants200JSONResponse) VisitGetV1NameIdVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameIdVariants400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameIdVariants400ApplicationjsonCharsetUTF8Response) VisitGetV1NameIdVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameIdVariants400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1NameIdVariants400JSONResponse) VisitGetV1NameIdVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameIdVariants404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1NameIdVariants404ApplicationjsonCharsetUTF8Response) VisitGetV1NameIdVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameIdVariants404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1NameIdVariants404JSONResponse) VisitGetV1NameIdVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NameIdVariantsdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1NameIdVariantsdefaultApplicationjsonCharsetUTF8Response) VisitGetV1NameIdVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NameIdVariantsdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1NameIdVariantsdefaultJSONResponse) VisitGetV1NameIdVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1NamedaysRequestObject struct {
+	Params GetV1NamedaysParams
+}
+
+type GetV1NamedaysResponseObject interface {
+	VisitGetV1NamedaysResponse(w http.ResponseWriter) error
+}
+
+type GetV1Namedays200JSONResponse NameDaysOnResponse
+
+func (response GetV1Namedays200JSONResponse) VisitGetV1NamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1Namedays400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1Namedays400ApplicationjsonCharsetUTF8Response) VisitGetV1NamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1Namedays400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1Namedays400JSONResponse) VisitGetV1NamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1NamedaysdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1NamedaysdefaultApplicationjsonCharsetUTF8Response) VisitGetV1NamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1NamedaysdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1NamedaysdefaultJSONResponse) VisitGetV1NamedaysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostV1SessionsRequestObject struct {
+	Body *PostV1SessionsJSONRequestBody
+}
+
+type PostV1SessionsResponseObject interface {
+	VisitPostV1SessionsResponse(w http.ResponseWriter) error
+}
+
+type PostV1Sessions201JSONResponse SessionCreated
+
+func (response PostV1Sessions201JSONResponse) VisitPostV1SessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Sessions400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1Sessions400ApplicationjsonCharsetUTF8Response) VisitPostV1SessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1Sessions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostV1Sessions400JSONResponse) VisitPostV1SessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1SessionsdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response PostV1SessionsdefaultApplicationjsonCharsetUTF8Response) VisitPostV1SessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1SessionsdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response PostV1SessionsdefaultJSONResponse) VisitPostV1SessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1SessionsIdRequestObject struct {
+	Id     SessionId `json:"id"`
+	Params GetV1SessionsIdParams
+}
+
+type GetV1SessionsIdResponseObject interface {
+	VisitGetV1SessionsIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1SessionsId200JSONResponse Session
+
+func (response GetV1SessionsId200JSONResponse) VisitGetV1SessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsId403ApplicationjsonCharsetUTF8Response struct {
+	ForbiddenApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1SessionsId403ApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(403)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetV1SessionsId403JSONResponse) VisitGetV1SessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsId404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1SessionsId404ApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1SessionsId404JSONResponse) VisitGetV1SessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsIddefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1SessionsIddefaultApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIddefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1SessionsIddefaultJSONResponse) VisitGetV1SessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1SessionsIdMatchesRequestObject struct {
+	Id     SessionId `json:"id"`
+	Params GetV1SessionsIdMatchesParams
+}
+
+type GetV1SessionsIdMatchesResponseObject interface {
+	VisitGetV1SessionsIdMatchesResponse(w http.ResponseWriter) error
+}
+
+type GetV1SessionsIdMatches200JSONResponse MatchesResponse
+
+func (response GetV1SessionsIdMatches200JSONResponse) VisitGetV1SessionsIdMatchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsIdMatches403ApplicationjsonCharsetUTF8Response struct {
+	ForbiddenApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1SessionsIdMatches403ApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdMatchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(403)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIdMatches403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetV1SessionsIdMatches403JSONResponse) VisitGetV1SessionsIdMatchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsIdMatches404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1SessionsIdMatches404ApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdMatchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIdMatches404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1SessionsIdMatches404JSONResponse) VisitGetV1SessionsIdMatchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsIdMatchesdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1SessionsIdMatchesdefaultApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdMatchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIdMatchesdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1SessionsIdMatchesdefaultJSONResponse) VisitGetV1SessionsIdMatchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1SessionsIdMatchesStreamRequestObject struct {
+	Id     SessionId `json:"id"`
+	Params GetV1SessionsIdMatchesStreamParams
+}
+
+type GetV1SessionsIdMatchesStreamResponseObject interface {
+	VisitGetV1SessionsIdMatchesStreamResponse(w http.ResponseWriter) error
+}
+
+type GetV1SessionsIdMatchesStream200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetV1SessionsIdMatchesStream200TexteventStreamResponse) VisitGetV1SessionsIdMatchesStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIdMatchesStream403ApplicationjsonCharsetUTF8Response struct {
+	ForbiddenApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1SessionsIdMatchesStream403ApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdMatchesStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(403)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIdMatchesStream403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetV1SessionsIdMatchesStream403JSONResponse) VisitGetV1SessionsIdMatchesStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsIdMatchesStream404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1SessionsIdMatchesStream404ApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdMatchesStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIdMatchesStream404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1SessionsIdMatchesStream404JSONResponse) VisitGetV1SessionsIdMatchesStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsIdMatchesStreamdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1SessionsIdMatchesStreamdefaultApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdMatchesStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIdMatchesStreamdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1SessionsIdMatchesStreamdefaultJSONResponse) VisitGetV1SessionsIdMatchesStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1SessionsIdNextRequestObject struct {
+	Id     SessionId `json:"id"`
+	Params GetV1SessionsIdNextParams
+}
+
+type GetV1SessionsIdNextResponseObject interface {
+	VisitGetV1SessionsIdNextResponse(w http.ResponseWriter) error
+}
+
+type GetV1SessionsIdNext200JSONResponse SessionNextResponse
+
+func (response GetV1SessionsIdNext200JSONResponse) VisitGetV1SessionsIdNextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsIdNext403ApplicationjsonCharsetUTF8Response struct {
+	ForbiddenApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1SessionsIdNext403ApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdNextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(403)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIdNext403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetV1SessionsIdNext403JSONResponse) VisitGetV1SessionsIdNextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsIdNext404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1SessionsIdNext404ApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdNextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIdNext404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1SessionsIdNext404JSONResponse) VisitGetV1SessionsIdNextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1SessionsIdNextdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1SessionsIdNextdefaultApplicationjsonCharsetUTF8Response) VisitGetV1SessionsIdNextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1SessionsIdNextdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1SessionsIdNextdefaultJSONResponse) VisitGetV1SessionsIdNextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostV1SessionsIdVotesRequestObject struct {
+	Id     SessionId `json:"id"`
+	Params PostV1SessionsIdVotesParams
+	Body   *PostV1SessionsIdVotesJSONRequestBody
+}
+
+type PostV1SessionsIdVotesResponseObject interface {
+	VisitPostV1SessionsIdVotesResponse(w http.ResponseWriter) error
+}
+
+type PostV1SessionsIdVotes200JSONResponse VoteResponse
+
+func (response PostV1SessionsIdVotes200JSONResponse) VisitPostV1SessionsIdVotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1SessionsIdVotes400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1SessionsIdVotes400ApplicationjsonCharsetUTF8Response) VisitPostV1SessionsIdVotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1SessionsIdVotes400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostV1SessionsIdVotes400JSONResponse) VisitPostV1SessionsIdVotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1SessionsIdVotes403ApplicationjsonCharsetUTF8Response struct {
+	ForbiddenApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1SessionsIdVotes403ApplicationjsonCharsetUTF8Response) VisitPostV1SessionsIdVotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(403)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1SessionsIdVotes403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostV1SessionsIdVotes403JSONResponse) VisitPostV1SessionsIdVotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1SessionsIdVotes404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1SessionsIdVotes404ApplicationjsonCharsetUTF8Response) VisitPostV1SessionsIdVotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1SessionsIdVotes404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostV1SessionsIdVotes404JSONResponse) VisitPostV1SessionsIdVotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1SessionsIdVotesdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response PostV1SessionsIdVotesdefaultApplicationjsonCharsetUTF8Response) VisitPostV1SessionsIdVotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1SessionsIdVotesdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response PostV1SessionsIdVotesdefaultJSONResponse) VisitPostV1SessionsIdVotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostV1ShortlistsRequestObject struct {
+}
+
+type PostV1ShortlistsResponseObject interface {
+	VisitPostV1ShortlistsResponse(w http.ResponseWriter) error
+}
+
+type PostV1Shortlists201JSONResponse Shortlist
+
+func (response PostV1Shortlists201JSONResponse) VisitPostV1ShortlistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1ShortlistsdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response PostV1ShortlistsdefaultApplicationjsonCharsetUTF8Response) VisitPostV1ShortlistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1ShortlistsdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response PostV1ShortlistsdefaultJSONResponse) VisitPostV1ShortlistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1ShortlistsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetV1ShortlistsIdResponseObject interface {
+	VisitGetV1ShortlistsIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1ShortlistsId200JSONResponse Shortlist
+
+func (response GetV1ShortlistsId200JSONResponse) VisitGetV1ShortlistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1ShortlistsId404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1ShortlistsId404ApplicationjsonCharsetUTF8Response) VisitGetV1ShortlistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1ShortlistsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1ShortlistsId404JSONResponse) VisitGetV1ShortlistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1ShortlistsIddefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1ShortlistsIddefaultApplicationjsonCharsetUTF8Response) VisitGetV1ShortlistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1ShortlistsIddefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1ShortlistsIddefaultJSONResponse) VisitGetV1ShortlistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostV1ShortlistsIdNamesRequestObject struct {
+	Id   string `json:"id"`
+	Body *PostV1ShortlistsIdNamesJSONRequestBody
+}
+
+type PostV1ShortlistsIdNamesResponseObject interface {
+	VisitPostV1ShortlistsIdNamesResponse(w http.ResponseWriter) error
+}
+
+type PostV1ShortlistsIdNames200JSONResponse Shortlist
+
+func (response PostV1ShortlistsIdNames200JSONResponse) VisitPostV1ShortlistsIdNamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1ShortlistsIdNames400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1ShortlistsIdNames400ApplicationjsonCharsetUTF8Response) VisitPostV1ShortlistsIdNamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1ShortlistsIdNames400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostV1ShortlistsIdNames400JSONResponse) VisitPostV1ShortlistsIdNamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1ShortlistsIdNames404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1ShortlistsIdNames404ApplicationjsonCharsetUTF8Response) VisitPostV1ShortlistsIdNamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1ShortlistsIdNames404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostV1ShortlistsIdNames404JSONResponse) VisitPostV1ShortlistsIdNamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1ShortlistsIdNamesdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response PostV1ShortlistsIdNamesdefaultApplicationjsonCharsetUTF8Response) VisitPostV1ShortlistsIdNamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1ShortlistsIdNamesdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response PostV1ShortlistsIdNamesdefaultJSONResponse) VisitPostV1ShortlistsIdNamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteV1ShortlistsIdNamesNameRequestObject struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Params DeleteV1ShortlistsIdNamesNameParams
+}
+
+type DeleteV1ShortlistsIdNamesNameResponseObject interface {
+	VisitDeleteV1ShortlistsIdNamesNameResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1ShortlistsIdNamesName200JSONResponse Shortlist
+
+func (response DeleteV1ShortlistsIdNamesName200JSONResponse) VisitDeleteV1ShortlistsIdNamesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1ShortlistsIdNamesName404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response DeleteV1ShortlistsIdNamesName404ApplicationjsonCharsetUTF8Response) VisitDeleteV1ShortlistsIdNamesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DeleteV1ShortlistsIdNamesName404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteV1ShortlistsIdNamesName404JSONResponse) VisitDeleteV1ShortlistsIdNamesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1ShortlistsIdNamesNamedefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response DeleteV1ShortlistsIdNamesNamedefaultApplicationjsonCharsetUTF8Response) VisitDeleteV1ShortlistsIdNamesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DeleteV1ShortlistsIdNamesNamedefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response DeleteV1ShortlistsIdNamesNamedefaultJSONResponse) VisitDeleteV1ShortlistsIdNamesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostV1TournamentRequestObject struct {
+	Body *PostV1TournamentJSONRequestBody
+}
+
+type PostV1TournamentResponseObject interface {
+	VisitPostV1TournamentResponse(w http.ResponseWriter) error
+}
+
+type PostV1Tournament201JSONResponse Tournament
+
+func (response PostV1Tournament201JSONResponse) VisitPostV1TournamentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Tournament400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1Tournament400ApplicationjsonCharsetUTF8Response) VisitPostV1TournamentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1Tournament400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostV1Tournament400JSONResponse) VisitPostV1TournamentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Tournament404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1Tournament404ApplicationjsonCharsetUTF8Response) VisitPostV1TournamentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1Tournament404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostV1Tournament404JSONResponse) VisitPostV1TournamentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1Tournament422ApplicationjsonCharsetUTF8Response struct {
+	UnprocessableEntityApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1Tournament422ApplicationjsonCharsetUTF8Response) VisitPostV1TournamentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(422)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1Tournament422JSONResponse struct {
+	UnprocessableEntityJSONResponse
+}
+
+func (response PostV1Tournament422JSONResponse) VisitPostV1TournamentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TournamentdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response PostV1TournamentdefaultApplicationjsonCharsetUTF8Response) VisitPostV1TournamentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1TournamentdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response PostV1TournamentdefaultJSONResponse) VisitPostV1TournamentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1TournamentIdRequestObject struct {
+	Id TournamentId `json:"id"`
+}
+
+type GetV1TournamentIdResponseObject interface {
+	VisitGetV1TournamentIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1TournamentId200JSONResponse Tournament
+
+func (response GetV1TournamentId200JSONResponse) VisitGetV1TournamentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TournamentId404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1TournamentId404ApplicationjsonCharsetUTF8Response) VisitGetV1TournamentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TournamentId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1TournamentId404JSONResponse) VisitGetV1TournamentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TournamentIddefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1TournamentIddefaultApplicationjsonCharsetUTF8Response) VisitGetV1TournamentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TournamentIddefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1TournamentIddefaultJSONResponse) VisitGetV1TournamentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1TournamentIdLeaderboardRequestObject struct {
+	Id     TournamentId `json:"id"`
+	Params GetV1TournamentIdLeaderboardParams
+}
+
+type GetV1TournamentIdLeaderboardResponseObject interface {
+	VisitGetV1TournamentIdLeaderboardResponse(w http.ResponseWriter) error
+}
+
+type GetV1TournamentIdLeaderboard200JSONResponse LeaderboardResponse
+
+func (response GetV1TournamentIdLeaderboard200JSONResponse) VisitGetV1TournamentIdLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TournamentIdLeaderboard400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1TournamentIdLeaderboard400ApplicationjsonCharsetUTF8Response) VisitGetV1TournamentIdLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TournamentIdLeaderboard400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1TournamentIdLeaderboard400JSONResponse) VisitGetV1TournamentIdLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TournamentIdLeaderboard404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1TournamentIdLeaderboard404ApplicationjsonCharsetUTF8Response) VisitGetV1TournamentIdLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TournamentIdLeaderboard404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1TournamentIdLeaderboard404JSONResponse) VisitGetV1TournamentIdLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TournamentIdLeaderboarddefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1TournamentIdLeaderboarddefaultApplicationjsonCharsetUTF8Response) VisitGetV1TournamentIdLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TournamentIdLeaderboarddefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1TournamentIdLeaderboarddefaultJSONResponse) VisitGetV1TournamentIdLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1TournamentIdPairRequestObject struct {
+	Id     TournamentId `json:"id"`
+	Params GetV1TournamentIdPairParams
+}
+
+type GetV1TournamentIdPairResponseObject interface {
+	VisitGetV1TournamentIdPairResponse(w http.ResponseWriter) error
+}
+
+type GetV1TournamentIdPair200JSONResponse TournamentPair
+
+func (response GetV1TournamentIdPair200JSONResponse) VisitGetV1TournamentIdPairResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TournamentIdPair400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1TournamentIdPair400ApplicationjsonCharsetUTF8Response) VisitGetV1TournamentIdPairResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TournamentIdPair400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1TournamentIdPair400JSONResponse) VisitGetV1TournamentIdPairResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TournamentIdPair404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1TournamentIdPair404ApplicationjsonCharsetUTF8Response) VisitGetV1TournamentIdPairResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TournamentIdPair404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetV1TournamentIdPair404JSONResponse) VisitGetV1TournamentIdPairResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TournamentIdPairdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1TournamentIdPairdefaultApplicationjsonCharsetUTF8Response) VisitGetV1TournamentIdPairResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TournamentIdPairdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1TournamentIdPairdefaultJSONResponse) VisitGetV1TournamentIdPairResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostV1TournamentIdVoteRequestObject struct {
+	Id     TournamentId `json:"id"`
+	Params PostV1TournamentIdVoteParams
+	Body   *PostV1TournamentIdVoteJSONRequestBody
+}
+
+type PostV1TournamentIdVoteResponseObject interface {
+	VisitPostV1TournamentIdVoteResponse(w http.ResponseWriter) error
+}
+
+type PostV1TournamentIdVote200JSONResponse TournamentPair
+
+func (response PostV1TournamentIdVote200JSONResponse) VisitPostV1TournamentIdVoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TournamentIdVote400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1TournamentIdVote400ApplicationjsonCharsetUTF8Response) VisitPostV1TournamentIdVoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(400)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1TournamentIdVote400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostV1TournamentIdVote400JSONResponse) VisitPostV1TournamentIdVoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TournamentIdVote404ApplicationjsonCharsetUTF8Response struct {
+	NotFoundApplicationjsonCharsetUTF8Response
+}
+
+func (response PostV1TournamentIdVote404ApplicationjsonCharsetUTF8Response) VisitPostV1TournamentIdVoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(404)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostV1TournamentIdVote404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostV1TournamentIdVote404JSONResponse) VisitPostV1TournamentIdVoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1TournamentIdVotedefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response PostV1TournamentIdVotedefaultApplicationjsonCharsetUTF8Response) VisitPostV1TournamentIdVoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.WriteHeader(response.StatusCode)
 
-	if params.Limit != nil {
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+type PostV1TournamentIdVotedefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response PostV1TournamentIdVotedefaultJSONResponse) VisitPostV1TournamentIdVoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1TrendsRequestObject struct {
+	Params GetV1TrendsParams
+}
+
+type GetV1TrendsResponseObject interface {
+	VisitGetV1TrendsResponse(w http.ResponseWriter) error
+}
 
+type GetV1Trends200JSONResponse TrendsResponse
+
+func (response GetV1Trends200JSONResponse) VisitGetV1TrendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1Trends400ApplicationjsonCharsetUTF8Response struct {
+	BadRequestApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1Trends400ApplicationjsonCharsetUTF8Response) VisitGetV1TrendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.WriteHeader(400)
 
-	queryURL.RawQuery = queryValues.Encode()
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1Trends400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetV1Trends400JSONResponse) VisitGetV1TrendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1Trends422ApplicationjsonCharsetUTF8Response struct {
+	UnprocessableEntityApplicationjsonCharsetUTF8Response
+}
+
+func (response GetV1Trends422ApplicationjsonCharsetUTF8Response) VisitGetV1TrendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(422)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1Trends422JSONResponse struct {
+	UnprocessableEntityJSONResponse
+}
+
+func (response GetV1Trends422JSONResponse) VisitGetV1TrendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1TrendsdefaultApplicationjsonCharsetUTF8Response struct {
+	Body          io.Reader
+	StatusCode    int
+	ContentLength int64
+}
+
+func (response GetV1TrendsdefaultApplicationjsonCharsetUTF8Response) VisitGetV1TrendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(response.StatusCode)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1TrendsdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetV1TrendsdefaultJSONResponse) VisitGetV1TrendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (POST /v1/compatibility)
+	PostV1Compatibility(ctx context.Context, request PostV1CompatibilityRequestObject) (PostV1CompatibilityResponseObject, error)
+
+	// (GET /v1/name)
+	GetV1Name(ctx context.Context, request GetV1NameRequestObject) (GetV1NameResponseObject, error)
+
+	// (GET /v1/name/by-value/{name})
+	GetV1NameByValueName(ctx context.Context, request GetV1NameByValueNameRequestObject) (GetV1NameByValueNameResponseObject, error)
+
+	// (GET /v1/name/random)
+	GetV1NameRandom(ctx context.Context, request GetV1NameRandomRequestObject) (GetV1NameRandomResponseObject, error)
+
+	// (GET /v1/name/search)
+	GetV1NameSearch(ctx context.Context, request GetV1NameSearchRequestObject) (GetV1NameSearchResponseObject, error)
+
+	// (GET /v1/name/{id})
+	GetV1NameId(ctx context.Context, request GetV1NameIdRequestObject) (GetV1NameIdResponseObject, error)
+
+	// (GET /v1/name/{id}/namedays)
+	GetV1NameIdNamedays(ctx context.Context, request GetV1NameIdNamedaysRequestObject) (GetV1NameIdNamedaysResponseObject, error)
+
+	// (GET /v1/name/{id}/similar)
+	GetV1NameIdSimilar(ctx context.Context, request GetV1NameIdSimilarRequestObject) (GetV1NameIdSimilarResponseObject, error)
+
+	// (GET /v1/name/{id}/variants)
+	GetV1NameIdVariants(ctx context.Context, request GetV1NameIdVariantsRequestObject) (GetV1NameIdVariantsResponseObject, error)
+
+	// (GET /v1/namedays)
+	GetV1Namedays(ctx context.Context, request GetV1NamedaysRequestObject) (GetV1NamedaysResponseObject, error)
+
+	// (POST /v1/sessions)
+	PostV1Sessions(ctx context.Context, request PostV1SessionsRequestObject) (PostV1SessionsResponseObject, error)
+
+	// (GET /v1/sessions/{id})
+	GetV1SessionsId(ctx context.Context, request GetV1SessionsIdRequestObject) (GetV1SessionsIdResponseObject, error)
+
+	// (GET /v1/sessions/{id}/matches)
+	GetV1SessionsIdMatches(ctx context.Context, request GetV1SessionsIdMatchesRequestObject) (GetV1SessionsIdMatchesResponseObject, error)
+
+	// (GET /v1/sessions/{id}/matches/stream)
+	GetV1SessionsIdMatchesStream(ctx context.Context, request GetV1SessionsIdMatchesStreamRequestObject) (GetV1SessionsIdMatchesStreamResponseObject, error)
+
+	// (GET /v1/sessions/{id}/next)
+	GetV1SessionsIdNext(ctx context.Context, request GetV1SessionsIdNextRequestObject) (GetV1SessionsIdNextResponseObject, error)
+
+	// (POST /v1/sessions/{id}/votes)
+	PostV1SessionsIdVotes(ctx context.Context, request PostV1SessionsIdVotesRequestObject) (PostV1SessionsIdVotesResponseObject, error)
+
+	// (POST /v1/shortlists)
+	PostV1Shortlists(ctx context.Context, request PostV1ShortlistsRequestObject) (PostV1ShortlistsResponseObject, error)
+
+	// (GET /v1/shortlists/{id})
+	GetV1ShortlistsId(ctx context.Context, request GetV1ShortlistsIdRequestObject) (GetV1ShortlistsIdResponseObject, error)
+
+	// (POST /v1/shortlists/{id}/names)
+	PostV1ShortlistsIdNames(ctx context.Context, request PostV1ShortlistsIdNamesRequestObject) (PostV1ShortlistsIdNamesResponseObject, error)
+
+	// (DELETE /v1/shortlists/{id}/names/{name})
+	DeleteV1ShortlistsIdNamesName(ctx context.Context, request DeleteV1ShortlistsIdNamesNameRequestObject) (DeleteV1ShortlistsIdNamesNameResponseObject, error)
+
+	// (POST /v1/tournament)
+	PostV1Tournament(ctx context.Context, request PostV1TournamentRequestObject) (PostV1TournamentResponseObject, error)
+
+	// (GET /v1/tournament/{id})
+	GetV1TournamentId(ctx context.Context, request GetV1TournamentIdRequestObject) (GetV1TournamentIdResponseObject, error)
+
+	// (GET /v1/tournament/{id}/leaderboard)
+	GetV1TournamentIdLeaderboard(ctx context.Context, request GetV1TournamentIdLeaderboardRequestObject) (GetV1TournamentIdLeaderboardResponseObject, error)
+
+	// (GET /v1/tournament/{id}/pair)
+	GetV1TournamentIdPair(ctx context.Context, request GetV1TournamentIdPairRequestObject) (GetV1TournamentIdPairResponseObject, error)
+
+	// (POST /v1/tournament/{id}/vote)
+	PostV1TournamentIdVote(ctx context.Context, request PostV1TournamentIdVoteRequestObject) (PostV1TournamentIdVoteResponseObject, error)
+
+	// (GET /v1/trends)
+	GetV1Trends(ctx context.Context, request GetV1TrendsRequestObject) (GetV1TrendsResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// PostV1Compatibility operation middleware
+func (sh *strictHandler) PostV1Compatibility(ctx echo.Context) error {
+	var request PostV1CompatibilityRequestObject
+
+	var body PostV1CompatibilityJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1Compatibility(ctx.Request().Context(), request.(PostV1CompatibilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1Compatibility")
+	}
+
+	response, err := handler(ctx, request)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(PostV1CompatibilityResponseObject); ok {
+		return validResponse.VisitPostV1CompatibilityResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	return req, nil
+// GetV1Name operation middleware
+func (sh *strictHandler) GetV1Name(ctx echo.Context, params GetV1NameParams) error {
+	var request GetV1NameRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1Name(ctx.Request().Context(), request.(GetV1NameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1Name")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetV1NameResponseObject); ok {
+		return validResponse.VisitGetV1NameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// NewGetV1NameIdRequest generates requests for GetV1NameId
-func NewGetV1NameIdRequest(server string, id int64, params *GetV1NameIdParams) (*http.Request, error) {
-	var err error
+// GetV1NameByValueName operation middleware
+func (sh *strictHandler) GetV1NameByValueName(ctx echo.Context, name string) error {
+	var request GetV1NameByValueNameRequestObject
 
-	var pathParam0 string
+	request.Name = name
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1NameByValueName(ctx.Request().Context(), request.(GetV1NameByValueNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1NameByValueName")
+	}
+
+	response, err := handler(ctx, request)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetV1NameByValueNameResponseObject); ok {
+		return validResponse.VisitGetV1NameByValueNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
+	return nil
+}
+
+// GetV1NameRandom operation middleware
+func (sh *strictHandler) GetV1NameRandom(ctx echo.Context, params GetV1NameRandomParams) error {
+	var request GetV1NameRandomRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1NameRandom(ctx.Request().Context(), request.(GetV1NameRandomRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1NameRandom")
+	}
+
+	response, err := handler(ctx, request)
 
-	serverURL, err := url.Parse(server)
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetV1NameRandomResponseObject); ok {
+		return validResponse.VisitGetV1NameRandomResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetV1NameSearch operation middleware
+func (sh *strictHandler) GetV1NameSearch(ctx echo.Context, params GetV1NameSearchParams) error {
+	var request GetV1NameSearchRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1NameSearch(ctx.Request().Context(), request.(GetV1NameSearchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1NameSearch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetV1NameSearchResponseObject); ok {
+		return validResponse.VisitGetV1NameSearchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetV1NameId operation middleware
+func (sh *strictHandler) GetV1NameId(ctx echo.Context, id int64, params GetV1NameIdParams) error {
+	var request GetV1NameIdRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1NameId(ctx.Request().Context(), request.(GetV1NameIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1NameId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetV1NameIdResponseObject); ok {
+		return validResponse.VisitGetV1NameIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetV1NameIdNamedays operation middleware
+func (sh *strictHandler) GetV1NameIdNamedays(ctx echo.Context, id int64, params GetV1NameIdNamedaysParams) error {
+	var request GetV1NameIdNamedaysRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1NameIdNamedays(ctx.Request().Context(), request.(GetV1NameIdNamedaysRequestObject))
 	}
-
-	operationPath := fmt.Sprintf("/v1/name/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1NameIdNamedays")
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetV1NameIdNamedaysResponseObject); ok {
+		return validResponse.VisitGetV1NameIdNamedaysResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	queryValues := queryURL.Query()
-
-	if params.Year != nil {
+// GetV1NameIdSimilar operation middleware
+func (sh *strictHandler) GetV1NameIdSimilar(ctx echo.Context, id int64, params GetV1NameIdSimilarParams) error {
+	var request GetV1NameIdSimilarRequestObject
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	request.Id = id
+	request.Params = params
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1NameIdSimilar(ctx.Request().Context(), request.(GetV1NameIdSimilarRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1NameIdSimilar")
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	response, err := handler(ctx, request)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetV1NameIdSimilarResponseObject); ok {
+		return validResponse.VisitGetV1NameIdSimilarResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
-
-	return req, nil
+	return nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// GetV1NameIdVariants operation middleware
+func (sh *strictHandler) GetV1NameIdVariants(ctx echo.Context, id int64, params GetV1NameIdVariantsParams) error {
+	var request GetV1NameIdVariantsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1NameIdVariants(ctx.Request().Context(), request.(GetV1NameIdVariantsRequestObject))
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1NameIdVariants")
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	response, err := handler(ctx, request)
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetV1NameIdVariantsResponseObject); ok {
+		return validResponse.VisitGetV1NameIdVariantsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
-	return &ClientWithResponses{client}, nil
+	return nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// GetV1Namedays operation middleware
+func (sh *strictHandler) GetV1Namedays(ctx echo.Context, params GetV1NamedaysParams) error {
+	var request GetV1NamedaysRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1Namedays(ctx.Request().Context(), request.(GetV1NamedaysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1Namedays")
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetV1Name request
-	GetV1NameWithResponse(ctx context.Context, params *GetV1NameParams, reqEditors ...RequestEditorFn) (*GetV1NameResponse, error)
+	response, err := handler(ctx, request)
 
-	// GetV1NameId request
-	GetV1NameIdWithResponse(ctx context.Context, id int64, params *GetV1NameIdParams, reqEditors ...RequestEditorFn) (*GetV1NameIdResponse, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetV1NamedaysResponseObject); ok {
+		return validResponse.VisitGetV1NamedaysResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetV1NameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *NamesPageResponse
-	JSONDefault  *Error
-}
+// PostV1Sessions operation middleware
+func (sh *strictHandler) PostV1Sessions(ctx echo.Context) error {
+	var request PostV1SessionsRequestObject
 
-// Status returns HTTPResponse.Status
-func (r GetV1NameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var body PostV1SessionsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
-	return http.StatusText(0)
-}
+	request.Body = &body
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1NameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1Sessions(ctx.Request().Context(), request.(PostV1SessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1Sessions")
 	}
-	return 0
-}
 
-type GetV1NameIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *NameEntry
-	JSONDefault  *Error
-}
+	response, err := handler(ctx, request)
 
-// Status returns HTTPResponse.Status
-func (r GetV1NameIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostV1SessionsResponseObject); ok {
+		return validResponse.VisitPostV1SessionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
-	return http.StatusText(0)
+	return nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetV1NameIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// GetV1SessionsId operation middleware
+func (sh *strictHandler) GetV1SessionsId(ctx echo.Context, id SessionId, params GetV1SessionsIdParams) error {
+	var request GetV1SessionsIdRequestObject
 
-// GetV1NameWithResponse request returning *GetV1NameResponse
-func (c *ClientWithResponses) GetV1NameWithResponse(ctx context.Context, params *GetV1NameParams, reqEditors ...RequestEditorFn) (*GetV1NameResponse, error) {
-	rsp, err := c.GetV1Name(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1SessionsId(ctx.Request().Context(), request.(GetV1SessionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1SessionsId")
 	}
-	return ParseGetV1NameResponse(rsp)
-}
 
-// GetV1NameIdWithResponse request returning *GetV1NameIdResponse
-func (c *ClientWithResponses) GetV1NameIdWithResponse(ctx context.Context, id int64, params *GetV1NameIdParams, reqEditors ...RequestEditorFn) (*GetV1NameIdResponse, error) {
-	rsp, err := c.GetV1NameId(ctx, id, params, reqEditors...)
+	response, err := handler(ctx, request)
+
 	if err != nil {
-		return nil, err
+		return err
+	} else if validResponse, ok := response.(GetV1SessionsIdResponseObject); ok {
+		return validResponse.VisitGetV1SessionsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
-	return ParseGetV1NameIdResponse(rsp)
+	return nil
 }
 
-// ParseGetV1NameResponse parses an HTTP response from a GetV1NameWithResponse call
-func ParseGetV1NameResponse(rsp *http.Response) (*GetV1NameResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// GetV1SessionsIdMatches operation middleware
+func (sh *strictHandler) GetV1SessionsIdMatches(ctx echo.Context, id SessionId, params GetV1SessionsIdMatchesParams) error {
+	var request GetV1SessionsIdMatchesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1SessionsIdMatches(ctx.Request().Context(), request.(GetV1SessionsIdMatchesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1SessionsIdMatches")
 	}
 
-	response := &GetV1NameResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetV1SessionsIdMatchesResponseObject); ok {
+		return validResponse.VisitGetV1SessionsIdMatchesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NamesPageResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// GetV1SessionsIdMatchesStream operation middleware
+func (sh *strictHandler) GetV1SessionsIdMatchesStream(ctx echo.Context, id SessionId, params GetV1SessionsIdMatchesStreamParams) error {
+	var request GetV1SessionsIdMatchesStreamRequestObject
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	request.Id = id
+	request.Params = params
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1SessionsIdMatchesStream(ctx.Request().Context(), request.(GetV1SessionsIdMatchesStreamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1SessionsIdMatchesStream")
 	}
 
-	return response, nil
-}
+	response, err := handler(ctx, request)
 
-// ParseGetV1NameIdResponse parses an HTTP response from a GetV1NameIdWithResponse call
-func ParseGetV1NameIdResponse(rsp *http.Response) (*GetV1NameIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		return nil, err
-	}
-
-	response := &GetV1NameIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		return err
+	} else if validResponse, ok := response.(GetV1SessionsIdMatchesStreamResponseObject); ok {
+		return validResponse.VisitGetV1SessionsIdMatchesStreamResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NameEntry
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// GetV1SessionsIdNext operation middleware
+func (sh *strictHandler) GetV1SessionsIdNext(ctx echo.Context, id SessionId, params GetV1SessionsIdNextParams) error {
+	var request GetV1SessionsIdNextRequestObject
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	request.Id = id
+	request.Params = params
 
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1SessionsIdNext(ctx.Request().Context(), request.(GetV1SessionsIdNextRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1SessionsIdNext")
 	}
 
-	return response, nil
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetV1SessionsIdNextResponseObject); ok {
+		return validResponse.VisitGetV1SessionsIdNextResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-
-	// (GET /v1/name)
-	GetV1Name(ctx echo.Context, params GetV1NameParams) error
+// PostV1SessionsIdVotes operation middleware
+func (sh *strictHandler) PostV1SessionsIdVotes(ctx echo.Context, id SessionId, params PostV1SessionsIdVotesParams) error {
+	var request PostV1SessionsIdVotesRequestObject
 
-	// (GET /v1/name/{id})
-	GetV1NameId(ctx echo.Context, id int64, params GetV1NameIdParams) error
-}
+	request.Id = id
+	request.Params = params
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
-}
+	var body PostV1SessionsIdVotesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-// GetV1Name converts echo context to params.
-func (w *ServerInterfaceWrapper) GetV1Name(ctx echo.Context) error {
-	var err error
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1SessionsIdVotes(ctx.Request().Context(), request.(PostV1SessionsIdVotesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1SessionsIdVotes")
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV1NameParams
-	// ------------- Optional query parameter "year" -------------
+	response, err := handler(ctx, request)
 
-	err = runtime.BindQueryParameter("form", true, false, "year", ctx.QueryParams(), &params.Year)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+		return err
+	} else if validResponse, ok := response.(PostV1SessionsIdVotesResponseObject); ok {
+		return validResponse.VisitPostV1SessionsIdVotesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	// ------------- Optional query parameter "page" -------------
+// PostV1Shortlists operation middleware
+func (sh *strictHandler) PostV1Shortlists(ctx echo.Context) error {
+	var request PostV1ShortlistsRequestObject
 
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1Shortlists(ctx.Request().Context(), request.(PostV1ShortlistsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1Shortlists")
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	response, err := handler(ctx, request)
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return err
+	} else if validResponse, ok := response.(PostV1ShortlistsResponseObject); ok {
+		return validResponse.VisitPostV1ShortlistsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
-
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetV1Name(ctx, params)
-	return err
+	return nil
 }
 
-// GetV1NameId converts echo context to params.
-func (w *ServerInterfaceWrapper) GetV1NameId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int64
+// GetV1ShortlistsId operation middleware
+func (sh *strictHandler) GetV1ShortlistsId(ctx echo.Context, id string) error {
+	var request GetV1ShortlistsIdRequestObject
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1ShortlistsId(ctx.Request().Context(), request.(GetV1ShortlistsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1ShortlistsId")
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetV1NameIdParams
-	// ------------- Optional query parameter "year" -------------
+	response, err := handler(ctx, request)
 
-	err = runtime.BindQueryParameter("form", true, false, "year", ctx.QueryParams(), &params.Year)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter year: %s", err))
+		return err
+	} else if validResponse, ok := response.(GetV1ShortlistsIdResponseObject); ok {
+		return validResponse.VisitGetV1ShortlistsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
 	}
-
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetV1NameId(ctx, id, params)
-	return err
+	return nil
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// PostV1ShortlistsIdNames operation middleware
+func (sh *strictHandler) PostV1ShortlistsIdNames(ctx echo.Context, id string) error {
+	var request PostV1ShortlistsIdNamesRequestObject
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
-}
+	request.Id = id
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	var body PostV1ShortlistsIdNamesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1ShortlistsIdNames(ctx.Request().Context(), request.(PostV1ShortlistsIdNamesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1ShortlistsIdNames")
 	}
 
-	router.GET(baseURL+"/v1/name", wrapper.GetV1Name)
-	router.GET(baseURL+"/v1/name/:id", wrapper.GetV1NameId)
+	response, err := handler(ctx, request)
 
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostV1ShortlistsIdNamesResponseObject); ok {
+		return validResponse.VisitPostV1ShortlistsIdNamesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetV1NameRequestObject struct {
-	Params GetV1NameParams
-}
+// DeleteV1ShortlistsIdNamesName operation middleware
+func (sh *strictHandler) DeleteV1ShortlistsIdNamesName(ctx echo.Context, id string, name string, params DeleteV1ShortlistsIdNamesNameParams) error {
+	var request DeleteV1ShortlistsIdNamesNameRequestObject
 
-type GetV1NameResponseObject interface {
-	VisitGetV1NameResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.Name = name
+	request.Params = params
 
-type GetV1Name200JSONResponse NamesPageResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1ShortlistsIdNamesName(ctx.Request().Context(), request.(DeleteV1ShortlistsIdNamesNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1ShortlistsIdNamesName")
+	}
 
-func (response GetV1Name200JSONResponse) VisitGetV1NameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteV1ShortlistsIdNamesNameResponseObject); ok {
+		return validResponse.VisitDeleteV1ShortlistsIdNamesNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetV1NamedefaultJSONResponse struct {
-	Body       Error
-	StatusCode int
-}
+// PostV1Tournament operation middleware
+func (sh *strictHandler) PostV1Tournament(ctx echo.Context) error {
+	var request PostV1TournamentRequestObject
 
-func (response GetV1NamedefaultJSONResponse) VisitGetV1NameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+	var body PostV1TournamentJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1Tournament(ctx.Request().Context(), request.(PostV1TournamentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1Tournament")
+	}
 
-type GetV1NameIdRequestObject struct {
-	Id     int64 `json:"id"`
-	Params GetV1NameIdParams
-}
+	response, err := handler(ctx, request)
 
-type GetV1NameIdResponseObject interface {
-	VisitGetV1NameIdResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostV1TournamentResponseObject); ok {
+		return validResponse.VisitPostV1TournamentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetV1NameId200JSONResponse NameEntry
+// GetV1TournamentId operation middleware
+func (sh *strictHandler) GetV1TournamentId(ctx echo.Context, id TournamentId) error {
+	var request GetV1TournamentIdRequestObject
 
-func (response GetV1NameId200JSONResponse) VisitGetV1NameIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1TournamentId(ctx.Request().Context(), request.(GetV1TournamentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1TournamentId")
+	}
 
-type GetV1NameIddefaultJSONResponse struct {
-	Body       Error
-	StatusCode int
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetV1TournamentIdResponseObject); ok {
+		return validResponse.VisitGetV1TournamentIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response GetV1NameIddefaultJSONResponse) VisitGetV1NameIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+// GetV1TournamentIdLeaderboard operation middleware
+func (sh *strictHandler) GetV1TournamentIdLeaderboard(ctx echo.Context, id TournamentId, params GetV1TournamentIdLeaderboardParams) error {
+	var request GetV1TournamentIdLeaderboardRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	request.Id = id
+	request.Params = params
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1TournamentIdLeaderboard(ctx.Request().Context(), request.(GetV1TournamentIdLeaderboardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1TournamentIdLeaderboard")
+	}
 
-	// (GET /v1/name)
-	GetV1Name(ctx context.Context, request GetV1NameRequestObject) (GetV1NameResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /v1/name/{id})
-	GetV1NameId(ctx context.Context, request GetV1NameIdRequestObject) (GetV1NameIdResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetV1TournamentIdLeaderboardResponseObject); ok {
+		return validResponse.VisitGetV1TournamentIdLeaderboardResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
+// GetV1TournamentIdPair operation middleware
+func (sh *strictHandler) GetV1TournamentIdPair(ctx echo.Context, id TournamentId, params GetV1TournamentIdPairParams) error {
+	var request GetV1TournamentIdPairRequestObject
 
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	request.Id = id
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1TournamentIdPair(ctx.Request().Context(), request.(GetV1TournamentIdPairRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1TournamentIdPair")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetV1TournamentIdPairResponseObject); ok {
+		return validResponse.VisitGetV1TournamentIdPairResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetV1Name operation middleware
-func (sh *strictHandler) GetV1Name(ctx echo.Context, params GetV1NameParams) error {
-	var request GetV1NameRequestObject
+// PostV1TournamentIdVote operation middleware
+func (sh *strictHandler) PostV1TournamentIdVote(ctx echo.Context, id TournamentId, params PostV1TournamentIdVoteParams) error {
+	var request PostV1TournamentIdVoteRequestObject
 
+	request.Id = id
 	request.Params = params
 
+	var body PostV1TournamentIdVoteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1Name(ctx.Request().Context(), request.(GetV1NameRequestObject))
+		return sh.ssi.PostV1TournamentIdVote(ctx.Request().Context(), request.(PostV1TournamentIdVoteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1Name")
+		handler = middleware(handler, "PostV1TournamentIdVote")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetV1NameResponseObject); ok {
-		return validResponse.VisitGetV1NameResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostV1TournamentIdVoteResponseObject); ok {
+		return validResponse.VisitPostV1TournamentIdVoteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetV1NameId operation middleware
-func (sh *strictHandler) GetV1NameId(ctx echo.Context, id int64, params GetV1NameIdParams) error {
-	var request GetV1NameIdRequestObject
+// GetV1Trends operation middleware
+func (sh *strictHandler) GetV1Trends(ctx echo.Context, params GetV1TrendsParams) error {
+	var request GetV1TrendsRequestObject
 
-	request.Id = id
 	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1NameId(ctx.Request().Context(), request.(GetV1NameIdRequestObject))
+		return sh.ssi.GetV1Trends(ctx.Request().Context(), request.(GetV1TrendsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1NameId")
+		handler = middleware(handler, "GetV1Trends")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetV1NameIdResponseObject); ok {
-		return validResponse.VisitGetV1NameIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetV1TrendsResponseObject); ok {
+		return validResponse.VisitGetV1TrendsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -730,17 +9582,114 @@ func (sh *strictHandler) GetV1NameId(ctx echo.Context, id int64, params GetV1Nam
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RVQW/bPAz9KwK/7yjUTjsMmM8thmBAN+ywS5GDajOJBltSKTloEPi/D5TsJG3sJsMG",
-	"bLsktkmRj4+P1A5K2zhr0AQPxQ58ucZGxcc7Ikv84Mg6pKAxfi5thfxfoS9Ju6CtgSI5i2iTsLTUqAAF",
-	"aBNurkFC2DpMr7hCgk5Cg96r1WSgwbw/6gNps4Kuk0D41GrCCooH6BMO7otOwr1q8M4E2p5CN6oZyRjW",
-	"KKLlNFkfzn9RK/yK3lnj8TRsrRsdJuK2zSOSsEuhAzZeOCThUmHHJL1/N0oSo/LTgD1IiGHZ5X/CJRTw",
-	"X3boZ9Y3MztQ0u3TKCIV391oGzgHW/oKLsMbbFD1eKxoek3HZVG3qGg8KFs42DEhZwO+UtBwLmbp2ZB9",
-	"S4eKFlEJ2iztKQ7mlsTxNwlBhxoHG0jYIPnknV/lVzMuyjo0ymko4CZ+4sxhHTuZbWbZoNQVjgjrI4ZU",
-	"rliSbYQSK71BI/oKWJmKPedV8v02u0/qdopUgwHJQ/FwCZ8gAZ9V4+qkwpx/NqpuubbrfPYBEi1QwFOL",
-	"tAXZT9hAZtIfn7qgK+cVOIllNgmkb+cvA3ljjqdR5ZOwBnn9DK4F6zatoJjrOs/TOjYBTVSJcq7WZex9",
-	"9t0z9t1RhnML4uWSi4p/yQNjF7R3YPNStXX4bSjShTOSuTX47LAMWAkcfDq5H5Rsp6vuzWlRUc7icSvm",
-	"t9MjMq/+uSGZ3zKU/voTwQrC0JK5cFh45RzwaC7/sBoDtfh3KbS/wk718fnTn5djJ8EjbQbdtFRDAesQ",
-	"XJFlu7X1gVnuMt75EjaKtHrsuzMYk3T7GqC2parZxNEX3Y8AAAD//z5zsIioCQAA",
+	"H4sIAAAAAAAC/+w925LbtpK/guKequzWcm6Oz2229sEZJzmTxI7LdvyQHO8WRLYknCEBBgCl0XHpYT9u",
+	"/2sLNxKkAJKSpZlMat9GQxBoNPqO7uanJGNlxShQKZLrT0mFOS5BAte/3oEQhNHbXP3IQWScVJIwmlwn",
+	"cgno9iVic6T+EmZgkiZEPaywXCZpQnEJyXVC8iRNOPxaEw55ci15DWkisiWUWM0rN5UaJSQndJFst6lb",
+	"9j27AxpemdAVkYCkGuFgqDCXJCMVptLB8WsNfNMCoofvCct7VnP1OpUxLNR0UYMQeFb4GJHNeymCFfAN",
+	"o4DWS4buKFsLRCTKMEUrJtVmem8cBY1bNVhUjArQZ/kVzt/CrzUIqX5ljEq10vWnBFdVQTKsdnTxD8Ho",
+	"f6BsibkA+Z8/vf/m7C9qSLvKHzjMk+vkXy5asrkwT8XF15wzrpHmT1lxNiug/Hc19fS53pi3zD66OCd0",
+	"hQuSI252g1qSTRGcL84RRhvAHK2XJFsiIugXEuEVJoU6oWSbJt8wPiN5DvSJI0IRjUOC3WZRsDXkFg87",
+	"jJIz0MNmUDC6QJJ1eHebJq+Z/IbVNP/9IAYUnQhW8wya7cM9EVJt9ycK9xVkEnID2NPedd3sBoFa8Ry9",
+	"KArzp0CYAzLDZ5CjNZFLFAMmRVlB1KKWgyoOc+Cojw5FPrEpEIcMyAo0fRWwwNkGaSwgNvsHZNIAoB4K",
+	"XAISEstaoIzlimCFBJyb46k4y6xo/ZpKIjdP/Ih2RZY+GC3PUjSrtVLQDIoElkTMScPMGFV4oZSckAar",
+	"6g9GQQNtl1aQ3UABM47Ngp+SirMKuCRGBwCV3P7ZBcw+cMpLaRynlpQsTdVKqAKOFkBz4CmCspIbRLzh",
+	"a6yZa0FWQM27WOqXkzQhEkoxhrfXuFSnzDfq7K02w5xj/dvowJD6VU9SJCooCshRQe4ayDNcAM01ALt6",
+	"vVWkv5jJ0wY7H7dpcsPKCksyIwWRGwPVDjbx+m6NeX5LiSS4CGB1vQS5BG4FsRmFSrxR58sB5wgLhCmC",
+	"cqb2KQShC4RnMw4rYg6wAXzGWAFYy+iZEtCYb95CBVgOL6oPBmguPIZTr5u/jLmhuI9LMyK4oDnysdP7",
+	"1ozapgmJ4sPHgyXr786/P989nzRRVhKdsDki1GljunGkm2OJBUgR3MohVOQmVMRO5BdC/ZNDCGa+3Mhl",
+	"GZ5fbAptf3RZDNPc/banYdHy7OzL0ArNLJFN1OUMuJoxvpx/8pItNDqTNJkzXipqSgiVf3rerk2ohIU5",
+	"V8kxkZO4+L0ZuU2TFVtDcVNgsZxKqDhOnQgjPV/waNeYU0IXAcws6xJTzW/aRPceauxUTAJVJImsuBe+",
+	"wNo5gq5gCgsSQ7wNxjyWSHeExg5Dd5DmH3lDYN5md0SVZ+J3hZUCLUI2c8KF1EcglFLPlpDdpeoQNl9w",
+	"QAVjd5CjutpliAVlCi0owwKM+qJMopzgjBNJMpEaRppt0MXq6kItcDHbnK1wUcPFJ/VzO4jqEt/fmod/",
+	"vEyTklD762pXPVhqifCeY62c1bMCzpS0NXxunxgtLECpZdmYR2i5qZbaXywJ/QHoQi79tSO6xEGSWowH",
+	"Tsg4ZbtHxEHUhQxRMNScCIVRNGfcuJPIrjJJtQa02fYwJCbT9++2YzBAZaNGurveW72MBiN866XjVO/K",
+	"tGGFMLpZ7ZLbkXYfareNN7E7rTWGwTOGU8RB1pxC7oxftRFrRWqGnGKOJ2kPr8qa3oVBg6Yt7Z7Q//JZ",
+	"EEElCIEX0Ync4zFE2QXdcIWkbwgUrd/VhZ2EVT8HF+gx1jMiQpttdanWcMEeGzVZAs61assYuyOgBW2+",
+	"ST4G1Gp0k+slVhp/zZW73JhQzfohFT1MUV6sKjZFWKUQ2kPftw3b7K5kSFErN2BVAdYo98ja4azEhfo5",
+	"B/1HCDc/aDTOGOZ5XHL5obcx78gbqmSOxDQP620HrtC6CM1ASGTks1ZYU2XfO7vCqPJuQVH4fYVltvx8",
+	"aVWqaSB/ETbUaevKeSjUWjP3j6th1BxLOJOkhP1Jbyql2R36oDf4ABGnATM8co7u4cQzM8gfOzA3qQJP",
+	"mZ0v8Ub8SOMQKuSFwcvxJkUGyYq+sECvXp29fBlDcmCT+t8os8435Ig5Y2ljyLdk6pBZVReY70fAvkcf",
+	"0NraxQ7uSj1xSxKpPSQDJhFIYhUQnHNWTjH+e4jXiLQr+3aOO4ThIwhgL8cboTBmNJzv3LUYjRzQVEu9",
+	"ZY+JMYgwd5gNuM1GAgMZq6kcc9FYltWcA812wy7YCmyL4Am+2emMqP1hKUHiKXh+pcYdILbShGN6F36j",
+	"YoKon123t1Tq25A+m7dhR6smD9rk/s7w1tLM34iQjG9ORDptxO4yGp17bJJqYSyJCXp9HqRHJ4djAzgs",
+	"og8QwPY1Qywfu4QVF75LM2DAOiXUOpfNfZkL//IcOOTKld83pNuh970ju1OtFrc3h4xXVgjtzloCpjrQ",
+	"qmJvnCyIpg5sI4CMFi6sraMfLpTTxSTITckKtgjgcsnWLalkWEfZ0CwoxiwkYbejnUQNE6H3DfQRPxPT",
+	"Ra0uDBhv/57jkhQbDzymeEAZATbs+K7AK5Kl6G8w47BO0Q9YEjp6BBaO1ENLuzl3IO8bgdlDpTHOI5sQ",
+	"EhUgI66WjaoNBbXiLxc2oDMsac37YhqbV1gNDhzIjRe2yRgVjGIqNQF+8J7osJ89iJsPNx/MW9+9+P6n",
+	"r44TC1YWlLogVtT/hhVELE3AW024vwyyCOxGKN2RpO5cW6w4QviAObF+4mGXUyszwR73U+6N015R3RGa",
+	"x+WBg4FDgaUJtJo7KsooyXChrVtfT3leejMoSZOclITWkqysXwhkQYOOu+P6AEKbR0by2Vk0BJb+gKYo",
+	"h4PM611StFsPX6/Yh8KFlacKfI1tf5fd+zuP0gbckT1dgjRx0O5utHuQwsgf7fpR1J6ZsP/xUS72oT7H",
+	"PRNvIhp4HU7EG7yAOEIKUpJR81MDq7mtMrG/CbJx4PrBPDrKJTHcB4DHKKu5YMoVJlRq1d9qV+HJRLmE",
+	"NhTLKIjW/mOdSM0iqM6rYAjRRPsWDn9Bk1KgNXA/ZcVeQBi4JyofDqu9915xyCCftnejUGObl0zGNLF+",
+	"1CefYxjOvrQUB2gw957VAIb23VYahnmLac7KYRkyHL3UDibX0xhD+ijELgAiHpd64nBjl10ABY4l4ymq",
+	"sNDph3iBiU7iWYBsnaDJuHzgw9G7bc7kHWCeLQ8/ExOPdHTvLg4+/0zMTMF13SI7nBNXKe6JQ2UDtJUZ",
+	"OliWM2XS6EftZtRfpYBCKxzn0jTTdYXNUTb+oLRgUKmI4U333qEv+9yNC+5E17MlE0CVK9vLGra2T1mX",
+	"qWIQCUUhFAPdqUsBNY83ViCsfulLb5t7WSvNLtWdHOSe3Xud/NcvL85+xmf/vDz763+fffx0lf7p+fYP",
+	"IVJwmWOBrdjcBJSDxKQQzdUhFl5aH6Ho7Tc36M9/ufzzjstqXgxMTRHcVwWmOsCMRAUZmZPM2KdEeLGm",
+	"5u7KQhnYgEk23F3EvmLTOAjNyYrkNS4CObxTydG7PgzQI6FCYppF9LFb1XBQhmthr1varTUkWnNypm9c",
+	"FQpCezbJi+GFllJWfnbjtDtXSWQBISIQS8YlEnVZYr7pHQfS86QxO70/GckVrc4JiNAkU3ff41MHgoa/",
+	"wczHNqM/EO/kgOXI/ZhNUFbum0kHgtyHcfBO7PhRzLbUYWexESnop5rwFeSHXr/orAMXAmzw56H5xvxT",
+	"gYKL4sd5cv3LyB1pkwK+exOv8sdFUG50cst1HANnS19Ipj3J6xGdEQTaicdFIwc+I/XKAdq6wkZEJlsP",
+	"Ma/hXh7NG/QqVaJZV/uS3yEUZO4XjZQ24Qyl7a31Pom81EaUaClIaA+TWNS9rpnUvjGZSScwnZs/RZiG",
+	"S2lMQi3NUclyMt90X9vjJrdruKtf2mZXf22M6YTzfLrZ1CA2YjuFeLvFuH+72ptp55w0WIOn5K4vzA6a",
+	"+g8PSacRqkdOTHAb7SBFMebR2HAsT5hItMTCRPbbcCKWqACblu8It3uRYtJbh7KMU51MiYjNrlRXLrWf",
+	"UqmYn34hJ2VW7oNVjUtSkgLz13bzXRRCTuRLMmRVtR6+GivQDOQaPMpze1Mo6G7I7XV6KGkvZ6VaMgqS",
+	"ZIeB797WBlznvlW0l6wbm1eP1bEc6OEkAUjTLt57p3TMyKYw0+7ixj5wO/Z9T2/PnXinP0YrK0vwDjvT",
+	"BGe7z6nRTrcHjSaX9rUrDAbkfkMDSvSJrtBUMOLJVPqk0lrThGMZvYb7umDIPPdBcaSgLXIl+67+eHnZ",
+	"USE627pdzCBX5+oTesgBmMzXiScwnqnbbDq1JGEB+9ip+j3MHmrP5zCvZQqJqtphc4NTFJ3QxDQCHSe4",
+	"EJFNN5+8FLO+iTiKpYlJ7S6nPBQddavdTrct+/H4To7chERwf82QEefOtUtgbzAJZEBrcbpPaquAjNF8",
+	"+hu9DbiMRDtNF8SoXXUQlrXr11SbqPiab1At8QpaS0quWRM1HD4BH5Qu9B+YjFuGBRPAx2Bnuk5pD/m7",
+	"JpSOT9vIs+lT9zZt10ntNvS+OdA84iEoUr7ZN8HMu2ZvVODkyEWq13wbTdBSpx9fob2DUtEFCmsEVPLp",
+	"Iu7UfopJPbtZYroYNSqrAme+QivZylRVOTQQukgRhQVW98Sm2NAOyk0K0oQNiyXmMARPpp85ApxznLn0",
+	"OKVEenmFsRS5aUpesuOQmmTT9i7ZIWQmWZfIcs4qVktxuA3vOVSaFweM9NkmkvvFiQDrLM5xUbg68TYL",
+	"L0SLDeiR5HTjp5rboDjHfV6uuid9AkrZ7iUGoHEYCiZkA8WR1lXSKh5VO0CqUVh/7UTRgehWlHdKZBsa",
+	"Gsa1ugKGvAHjOAtLNgXX09i6b6VYW0y9q5nAbrIlre7ReEyh+HHQEtg/f3n3RmAC5WgXeLAWul8JJNCu",
+	"BmoKn0N2qF6i3e9gwc7ESpytDhITOg+crfLQuV9W3VwB2WdJmqyAm/uf5PL88vxKIYJVQHFFkuvkS/0v",
+	"U7inIVMxrcwvWdXAMxFQJjeqWBmpJDu/itk2ObDl7S6dpi1NVWjQ953Kbk3eMCE/XHVqZG2nJRDyK1U0",
+	"ONx1ZHprkGCp9na77fd16vduenZ5eSoYzCqhZiXRquNeYNxyAXfbSZPnl5cxKJptXXj9qPTSc1wXcvy1",
+	"fs8eQ5kuCmqCvQE6+RYcbWiHtFfw0aWHb0F+uHptI3JeP7RfpuQ3JGkC97isbGKuRoSOyybXybPLq78m",
+	"2224OZkFpT3ECcJxJNlsCJarKCA2xeuzARlIGIxDdRkFy6VnfRZcJsXNaOXGvHXE0Fi3UfBsnWwMxGaC",
+	"aZzoHI9tGk3ba1LysEAquxExjlSmn4r8Y/0XYbVwvJe2qsm10TAgIdwJZ7gLQ8F42nYcMlkQHZmpjitF",
+	"c1JI4MK6wXKJaZNmzTgSjMf67TX5iwMN9sZPyEjvJiKwJAKVmG683PzQ2m3PhkGSKQklpcqyvjyMfBxw",
+	"JdsHNnz/kLD1EddN2A+i7p035OGxNwogvn8gAHVIkrjifyMm7NlOuc2KCpIyKkPaCorPYhpTfnFssHEU",
+	"7Kbc47Og9oSxKSoK1iUpqaMrk3YuS4dgN3NEN9AUMe2xAe2rezkZjEvtnac+ecOvKtFNQ9Ebhm5fRgjc",
+	"ytQWlMY8Mha+KwzxbzWC9Ti2QjAd34vpDcZlm6EcRFJfv7WAYZF5kJlfaonQ6h9PaN/uVjkEbFu1H8Sb",
+	"AQeZrM+fPZtiru72MDyeubtz6T9k/uqERFMm2VY8IpxxJoQO//VyFc7Re+f0tskOR8ldmIHOubRckpP5",
+	"HLipNDDBkvYVy/+vbr//8X//58V3WmypHz+8+C41PS31y0AdH/ZAOm/zI4TOGUdzQnPhBrsGVu2C53Fv",
+	"4KvNB4XqqY7BeEe5vcTXzz/9/OL16xet/Oo2B256Lk1tD3xqFuxXI0fatA6VHRu+fD7OJE3b2iNylimc",
+	"GGQoM2R/t9JUlBziXIpH9S6nOU8ndYy6fmVOhCQ083rXGRBTNIe19Xra3lpz07nRSiEElNWLZYPWoP+i",
+	"tWdQ3V2lh2CwItldU3rFKsbV/3FRbFwuXuxepGkLVlOi1i02EZDXQBZLc4kegHqOCwGhIGIg13ywiKht",
+	"3auGKQoQvRoihAXSXaax8IVe04TZ3ESdoxdudj0TEcYNbRNYU9N0sz1GI0MdH5/HLCjo4eCA07p96V2D",
+	"2YynJauL3DrMDiarpPxxJk+04IDzjdoZ1YxbFbonmxHNIajhPivqvBt9aSLyU+7AuklSJzezerVxARnv",
+	"y8jHDQ1aAyAq0U1NWUyap0GHsC246ogamws37mhFFIQBZYqC0Iu3HaI5zMk9Ytzm3U+NSxb1HRb/jOqO",
+	"XwcNi5HmmE8iZlriexUm8MRvT6M8ZszS3Q0NlwNaIvBKzc31sV9onlr55QsvJBmLyFC34H665ORCp1f8",
+	"GfHugjWejyuAPpF82EOzLpmLDESkw23+5O4lzB2qbV85katins7IZ1DGoTs1gXrlQV0s/Pj9oZGGx3KC",
+	"FMXqv1SDvkHS7Xbww4H+fehfSUmAErr5tyHafu1WO4zGT0vB/YWeGGV2ejMOOeRKcxBpwiT6aDsdwHIs",
+	"IfQFCFMH3f/4wlMkea8YYuQ2OdBAzq+N0GEfV4jOg8UQUU6wBRCHxgmeNCf85q20U7JqsMJnjF33KdN5",
+	"kjzp98mIMmWvC1MatYcVba6UiJKiDYYPceOH1hjemx3973x4zcyUhPVs7P9XXeN9r/ZTXw65IWWlrjSo",
+	"KSGmzcAnxhijVlmoK7WLauR445lkaS/zQy6B+M2j46wx1ViL9NhWCytQyFx/PMR0gItK8cs/nz37U1SQ",
+	"277ULVX5PVguz/768dOz7Zn74w/JPtGKKW20j8TAD2EFem3So/7zfs3MH821ttm3Ip4j+s5UKDZ9REjT",
+	"aHzNOoVzNoZSYQ76l/46J3P1rITmUAHNgcpic460erat+mcbNGNy2WsQxJu+9+eRnNN3DvbTpJv2ulNs",
+	"dz8K+uzy6tirueYjEQFty+Lapi46BWhCM5HHp7ApAZw2FzwgLt1xhyI4IeDaIRftl3i36dTB5vu5pzVV",
+	"7XYjh+19WvT55ZfjZ9B+mfWRtGrnqC+8T1qMqNi4GBijBPtdjd8LQfQ/ExIhDIvZTljBlQXbLitmSP7E",
+	"aedCSA44nkfwTj92+EBYmM4+/EwAlQhWavVz9LXOjtC/EBEIo7+b6o2/J/aftsJBY999YxULbQSri9dz",
+	"ZM8FlTgHNAPlBpnAhVlflV+zCnSmMQekF9d6/Xwi/Zp9/LaoWMK9vND4OWtPYfCD2TuX4AY7KnZt2fSJ",
+	"UqPrjDuYHKYGGeeoX4a0NI2zV8wagxuQ6W4zqkBVCOHo9uWoDFRtuX5nGrHTaSzmqzp8P1WqUvQwYHe/",
+	"hYzxHA3XtrkPL6yYtM2GsXXidX9aDl7hdFPvoBYeMalv8w8auocmq+Nb8X7V4gPXinUKCCNUrJ0kpT+4",
+	"Pm6nsQ8IojwJBnBtJgbo3nhA5svOKuzUvKOE4hyvWM2JbAP1QSpulzmlx+ZWGXXW3MBUmxS3LxERiALY",
+	"LnI4y8A0lS6wBH50VE9yvPZBstE/zfzTbtQDvUwOCqY+ZCbu6Am3W3l0hmpvmwd460WeOwWhCM+nTJzr",
+	"khf71ER4iGiy8Fi/U6WNAMN8Dpkc5UNzMy0enVBOECUKtVB8YEUzSqd1lXcl0RMK1QdJ3KvYyKGA0Jcp",
+	"34Lq/+II2qYkegTP1fMAyStTfU9qf6lBCND71GqHU9F8Gi+t2C2eOEZdxM6C3JxC+wVflf4+Z7y5SHG1",
+	"sP5nK81L+rrRhITMINHtzHvMVPmPv0HufHhWk92+fYPXAe3YptenzemgXT7rRPV1K6G2Udxsg1RSv0sz",
+	"nIGUwJsGsGuGsF6KlHCO3vRuB3a+GpCaMphue+NYTz7d9Fgb37Up8aYbH1LLJIIh3RQKEWko19R1m89C",
+	"S17rz81IpieK3VK89zuSnUID7Xaam6R+rk4AwJgl7BHYAymgRy4zbDc8xQrvNK8L2Nwtog+4A+m8fFKB",
+	"N04PfTp4TFFnrIqi/VD7tLy1jgRUYs2E4U3Uru35On6Q3ifiP/NMd5WvkraNpG07nlrV2/nWQLQfqo5R",
+	"9sqKkljvk+a1ySq480X7k5Jl6Fv8Efr0qGHn8/nGnHxiiWB9cq9s29R4RNu1DrXGmq8bJXONyLqZcv0Q",
+	"ZdutzKBUSE1LbeNOTHOkIHHfbPOYyyXkTeUi3Qf2yOzT288koo+byr8NJuh1zo3QvyaPp0vdOsA9HlhX",
+	"NGvtTHsb0yFfQ6di1KwzsfLfFfGd0kh9xID8NOKnsEbCdnrW0ki7oJ9Tg/koPKG7p0Zl/I2RzcHMvObT",
+	"E2tmellExK9ZYWpGb6s1XMbfQEXVs2fRNEX78tHz4/tASjYC4pdRECU7BYCxBH5CTbaXjRoNgHzqSssp",
+	"vXft95aaTsraRmW891/jde82Vo6IwdmmA3zDPtoC9vrq2J969ofurNNrZxwSPnoE4lAxLp9aWx3dRJ+v",
+	"nBCoeZFcJ0spq+uLi09LJqQO2F7girgPK7uvr7uH3ZMrWIYL9UjN/nH7fwMAW+Ege2qfAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,191 @@
+package favouritesdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mwasilew2/go-service-template/internal/adapters/localstate"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+var ErrShortlistNotFound = ports.ErrShortlistNotFound
+
+var fileWithShortlists = "favourites.json"
+
+type shortlistRecord struct {
+	CreatedAt time.Time     `json:"createdAt"`
+	Names     []entryRecord `json:"names"`
+}
+
+type entryRecord struct {
+	Value   string        `json:"value"`
+	Gender  models.Gender `json:"gender"`
+	AddedAt time.Time     `json:"addedAt"`
+}
+
+// FavouritesDB keeps shortlists in memory and writes all of them to a JSON file in a state directory after every
+// change
+type FavouritesDB struct {
+	path string
+
+	mu         sync.Mutex
+	shortlists map[string]*shortlistRecord
+}
+
+// NewFavouritesDB loads shortlists stored in stateDir, the directory is created if it doesn't exist
+func NewFavouritesDB(stateDir string) (*FavouritesDB, error) {
+	if err := os.MkdirAll(stateDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create state directory %s: %w", stateDir, err)
+	}
+	favouritesDB := &FavouritesDB{
+		path:       filepath.Join(stateDir, fileWithShortlists),
+		shortlists: make(map[string]*shortlistRecord),
+	}
+
+	data, err := os.ReadFile(favouritesDB.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return favouritesDB, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", favouritesDB.path, err)
+	}
+	if err := json.Unmarshal(data, &favouritesDB.shortlists); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", favouritesDB.path, err)
+	}
+
+	return favouritesDB, nil
+}
+
+func (f *FavouritesDB) CreateShortlist(ctx context.Context) (*models.Shortlist, error) {
+	id, err := localstate.NewId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate shortlist id: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record := &shortlistRecord{
+		CreatedAt: time.Now().UTC(),
+		Names:     []entryRecord{},
+	}
+	if err := f.update(id, record); err != nil {
+		return nil, err
+	}
+
+	return toShortlist(id, record), nil
+}
+
+func (f *FavouritesDB) GetShortlist(ctx context.Context, id string) (*models.Shortlist, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record, ok := f.shortlists[id]
+	if !ok {
+		return nil, ErrShortlistNotFound
+	}
+
+	return toShortlist(id, record), nil
+}
+
+func (f *FavouritesDB) AddName(ctx context.Context, id string, value string, gender models.Gender) (*models.Shortlist, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record, ok := f.shortlists[id]
+	if !ok {
+		return nil, ErrShortlistNotFound
+	}
+	for _, entry := range record.Names {
+		if strings.EqualFold(entry.Value, value) && entry.Gender == gender {
+			return toShortlist(id, record), nil
+		}
+	}
+
+	updated := &shortlistRecord{
+		CreatedAt: record.CreatedAt,
+		Names: append(append([]entryRecord(nil), record.Names...), entryRecord{
+			Value:   value,
+			Gender:  gender,
+			AddedAt: time.Now().UTC(),
+		}),
+	}
+	if err := f.update(id, updated); err != nil {
+		return nil, err
+	}
+
+	return toShortlist(id, updated), nil
+}
+
+func (f *FavouritesDB) RemoveName(ctx context.Context, id string, value string, gender models.Gender) (*models.Shortlist, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record, ok := f.shortlists[id]
+	if !ok {
+		return nil, ErrShortlistNotFound
+	}
+
+	updated := &shortlistRecord{
+		CreatedAt: record.CreatedAt,
+		Names:     []entryRecord{},
+	}
+	for _, entry := range record.Names {
+		if strings.EqualFold(entry.Value, value) && (gender == "" || entry.Gender == gender) {
+			continue
+		}
+		updated.Names = append(updated.Names, entry)
+	}
+	if len(updated.Names) == len(record.Names) {
+		return toShortlist(id, record), nil
+	}
+	if err := f.update(id, updated); err != nil {
+		return nil, err
+	}
+
+	return toShortlist(id, updated), nil
+}
+
+// update replaces a shortlist and saves all shortlists, the change is undone if saving fails. The caller must hold
+// the lock.
+func (f *FavouritesDB) update(id string, record *shortlistRecord) error {
+	previous, existed := f.shortlists[id]
+	f.shortlists[id] = record
+	if err := f.save(); err != nil {
+		if existed {
+			f.shortlists[id] = previous
+		} else {
+			delete(f.shortlists, id)
+		}
+		return err
+	}
+	return nil
+}
+
+func (f *FavouritesDB) save() error {
+	data, err := json.Marshal(f.shortlists)
+	if err != nil {
+		return fmt.Errorf("failed to marshal shortlists: %w", err)
+	}
+	return localstate.WriteFile(f.path, data)
+}
+
+func toShortlist(id string, record *shortlistRecord) *models.Shortlist {
+	shortlist := &models.Shortlist{
+		Id:        id,
+		CreatedAt: record.CreatedAt,
+		Names:     make([]*models.ShortlistEntry, 0, len(record.Names)),
+	}
+	for _, entry := range record.Names {
+		shortlist.Names = append(shortlist.Names, &models.ShortlistEntry{
+			Value:   entry.Value,
+			Gender:  entry.Gender,
+			AddedAt: entry.AddedAt,
+		})
+	}
+	return shortlist
+}
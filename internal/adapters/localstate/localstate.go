@@ -0,0 +1,49 @@
+// Package localstate contains helpers shared by adapters which keep state in files in a local directory
+package localstate
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// idBytes is the number of random bytes in an id, enough to make ids unguessable
+const idBytes = 16
+
+// NewId returns a random, unguessable and URL-safe id
+func NewId() (string, error) {
+	id := make([]byte, idBytes)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate id: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(id), nil
+}
+
+// WriteFile replaces a file with new contents. The contents are written to a temporary file first, so that a
+// failure never leaves a half-written file behind.
+func WriteFile(path string, data []byte) error {
+	fd, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create a temporary file: %w", err)
+	}
+	defer func() {
+		// no-ops if the temporary file has been renamed already
+		fd.Close()
+		os.Remove(fd.Name())
+	}()
+	if _, err := fd.Write(data); err != nil {
+		return fmt.Errorf("failed to write to %s: %w", fd.Name(), err)
+	}
+	if err := fd.Sync(); err != nil {
+		return fmt.Errorf("failed to sync %s: %w", fd.Name(), err)
+	}
+	if err := fd.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", fd.Name(), err)
+	}
+	if err := os.Rename(fd.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package namedaysdb
+
+import (
+	"context"
+	"embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+//go:embed namedays.csv
+var nameDaysEmbedded embed.FS
+var fileWithNameDays = "namedays.csv"
+
+// NameDaysDB is a calendar of Polish name days (imieniny), a name is usually celebrated on a few days a year
+type NameDaysDB struct {
+	// names celebrated on a given day, in the order of the calendar
+	byDay map[models.NameDay][]string
+	// days on which a name with a given upper-cased value is celebrated, ordered by date
+	byValue map[string][]models.NameDay
+}
+
+// NewNameDaysDB loads a calendar from a CSV file with date (MM-DD) and name columns. If path is empty, the embedded
+// calendar is loaded instead.
+func NewNameDaysDB(path string) (*NameDaysDB, error) {
+	nameDaysDB := &NameDaysDB{
+		byDay:   make(map[models.NameDay][]string),
+		byValue: make(map[string][]models.NameDay),
+	}
+
+	if path == "" {
+		if err := nameDaysDB.loadFile(nameDaysEmbedded, fileWithNameDays); err != nil {
+			return nil, fmt.Errorf("failed to load embedded calendar: %w", err)
+		}
+	} else {
+		if err := nameDaysDB.loadFile(os.DirFS(filepath.Dir(path)), filepath.Base(path)); err != nil {
+			return nil, fmt.Errorf("failed to load calendar from %s: %w", path, err)
+		}
+	}
+
+	for _, days := range nameDaysDB.byValue {
+		sort.Slice(days, func(i, j int) bool {
+			if days[i].Month != days[j].Month {
+				return days[i].Month < days[j].Month
+			}
+			return days[i].Day < days[j].Day
+		})
+	}
+
+	return nameDaysDB, nil
+}
+
+func (n *NameDaysDB) loadFile(fsys fs.FS, filename string) error {
+	fd, err := fsys.Open(filename)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filename, err)
+	}
+	defer fd.Close()
+	r := csv.NewReader(fd)
+	r.FieldsPerRecord = 2 // date,name
+
+	header := true
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", filename, err)
+		}
+		if header {
+			header = false
+			if record[0] == "date" {
+				continue
+			}
+		}
+		day, err := models.ParseNameDay(record[0])
+		if err != nil {
+			return fmt.Errorf("failed to parse date %s in %s: %w", record[0], filename, err)
+		}
+		value := strings.TrimSpace(record[1])
+		if value == "" {
+			return fmt.Errorf("empty name on %s in %s", record[0], filename)
+		}
+
+		key := strings.ToUpper(value)
+		duplicate := false
+		for _, existing := range n.byValue[key] {
+			duplicate = duplicate || existing == day
+		}
+		if duplicate {
+			continue
+		}
+		n.byDay[day] = append(n.byDay[day], value)
+		n.byValue[key] = append(n.byValue[key], day)
+	}
+
+	return nil
+}
+
+func (n *NameDaysDB) GetNameDays(ctx context.Context, value string) ([]models.NameDay, error) {
+	return append([]models.NameDay{}, n.byValue[strings.ToUpper(strings.TrimSpace(value))]...), nil
+}
+
+func (n *NameDaysDB) GetNames(ctx context.Context, day models.NameDay) ([]string, error) {
+	return append([]string{}, n.byDay[day]...), nil
+}
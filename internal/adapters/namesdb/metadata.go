@@ -0,0 +1,66 @@
+package namesdb
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/normalize"
+)
+
+//go:embed metadata.json
+var metadataEmbedded embed.FS
+
+// fileWithMetadata holds the meaning and origin of names, a file with this name in the data directory replaces the
+// embedded one
+var fileWithMetadata = "metadata.json"
+
+type metadataRecord struct {
+	Name      string `json:"name"`
+	Origin    string `json:"origin"`
+	Etymology string `json:"etymology"`
+	Meaning   string `json:"meaning"`
+}
+
+// loadMetadata returns the meaning and origin of names keyed by folded values, so that e.g. ŁUKASZ matches Łukasz.
+// The embedded metadata is used if dataDir is empty or doesn't contain any.
+func loadMetadata(dataDir string) (map[string]*models.NameMeta, error) {
+	var fsys fs.FS = metadataEmbedded
+	if dataDir != "" {
+		if _, err := os.Stat(filepath.Join(dataDir, fileWithMetadata)); err == nil {
+			fsys = os.DirFS(dataDir)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to stat %s: %w", fileWithMetadata, err)
+		}
+	}
+
+	data, err := fs.ReadFile(fsys, fileWithMetadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", fileWithMetadata, err)
+	}
+	var records []metadataRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", fileWithMetadata, err)
+	}
+	metadata := make(map[string]*models.NameMeta, len(records))
+	for _, record := range records {
+		key := normalize.Fold(record.Name)
+		if key == "" {
+			return nil, fmt.Errorf("metadata without a name in %s", fileWithMetadata)
+		}
+		if _, ok := metadata[key]; ok {
+			return nil, fmt.Errorf("duplicate metadata of %s in %s", record.Name, fileWithMetadata)
+		}
+		metadata[key] = &models.NameMeta{
+			Origin:    record.Origin,
+			Etymology: record.Etymology,
+			Meaning:   record.Meaning,
+		}
+	}
+	return metadata, nil
+}
@@ -4,25 +4,39 @@ import (
 	"context"
 	"embed"
 	"encoding/csv"
-	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"os"
+	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/mwasilew2/go-service-template/internal/domain/analysis"
 	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/normalize"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
 )
 
 //go:embed names_transformed.csv
 var namesEmbedded embed.FS
 var fileWithTransformedNames = "names_transformed.csv"
 
-var ErrYearNotFound = errors.New("year not found")
-var ErrNameNotFound = errors.New("name not found")
+var ErrYearNotFound = ports.ErrYearNotFound
+var ErrNameNotFound = ports.ErrNameNotFound
 
-type Entries map[int64]string
+type Entries map[int64]*models.Name
 type YearDB struct {
 	Entries
 	maxId int64
+	// ids of all entries, in ascending order
+	ids []int64
+	// ids of the entries of a given gender, in ascending order
+	byGender map[models.Gender][]int64
+	// ids of the entries with a given upper-cased value, in ascending order
+	byValue map[string][]int64
+	search  *searchIndex
+	sounds  *soundIndex
 }
 
 type NamesDB struct {
@@ -30,20 +44,92 @@ type NamesDB struct {
 	years    map[int64]struct{}
 }
 
-func NewNamesDB() (*NamesDB, error) {
+// NewNamesDB loads all *.csv datasets found in dataDir. If dataDir is empty, the embedded dataset is loaded instead.
+func NewNamesDB(dataDir string) (*NamesDB, error) {
 	namesDB := &NamesDB{
 		database: make(map[int64]*YearDB),
 		years:    make(map[int64]struct{}),
 	}
 
-	// open embedded file
-	fs, err := namesEmbedded.Open(fileWithTransformedNames)
+	if dataDir == "" {
+		if err := namesDB.loadFile(namesEmbedded, fileWithTransformedNames); err != nil {
+			return nil, fmt.Errorf("failed to load embedded dataset: %w", err)
+		}
+	} else {
+		dirFS := os.DirFS(dataDir)
+		files, err := fs.Glob(dirFS, "*.csv")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list datasets in %s: %w", dataDir, err)
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no datasets found in %s", dataDir)
+		}
+		for _, file := range files {
+			if err := namesDB.loadFile(dirFS, file); err != nil {
+				return nil, fmt.Errorf("failed to load dataset from %s: %w", dataDir, err)
+			}
+		}
+	}
+
+	metadata, err := loadMetadata(dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load metadata: %w", err)
+	}
+
+	// files don't have to be sorted by id, so indexes can be built only once everything is loaded
+	for _, yearDB := range namesDB.database {
+		yearDB.buildIndexes(metadata)
+	}
+
+	return namesDB, nil
+}
+
+func (y *YearDB) buildIndexes(metadata map[string]*models.NameMeta) {
+	ids := make([]int64, 0, len(y.Entries))
+	for id := range y.Entries {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	y.ids = ids
+
+	y.byValue = make(map[string][]int64)
+	for _, id := range ids {
+		key := strings.ToUpper(y.Entries[id].Value)
+		y.byValue[key] = append(y.byValue[key], id)
+		y.Entries[id].Traits = analysis.Analyze(y.Entries[id].Value)
+		y.Entries[id].Meta = metadata[normalize.Fold(y.Entries[id].Value)]
+	}
+
+	for _, genderIds := range y.byGender {
+		sort.Slice(genderIds, func(i, j int) bool { return genderIds[i] < genderIds[j] })
+
+		// rank names of each gender by the number of occurrences, equally popular names share a rank
+		byCount := append([]int64(nil), genderIds...)
+		sort.SliceStable(byCount, func(i, j int) bool { return y.Entries[byCount[i]].Count > y.Entries[byCount[j]].Count })
+		for i, id := range byCount {
+			if i > 0 && y.Entries[byCount[i-1]].Count == y.Entries[id].Count {
+				y.Entries[id].Rank = y.Entries[byCount[i-1]].Rank
+				continue
+			}
+			y.Entries[id].Rank = int64(i + 1)
+		}
+	}
+
+	y.search = newSearchIndex(y.Entries, ids)
+	y.sounds = newSoundIndex(y.Entries, ids)
+}
+
+func (n *NamesDB) loadFile(fsys fs.FS, filename string) error {
+	// open file
+	fd, err := fsys.Open(filename)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open embedded %s: %w", fileWithTransformedNames, err)
+		return fmt.Errorf("failed to open %s: %w", filename, err)
 	}
-	r := csv.NewReader(fs)
+	defer fd.Close()
+	r := csv.NewReader(fd)
+	r.FieldsPerRecord = 5 // year,id,name,gender,count
 
-	// read the embedded file line by line
+	// read the file line by line
 FILE_READING_LOOP:
 	for {
 		record, err := r.Read()
@@ -52,7 +138,7 @@ FILE_READING_LOOP:
 			case err == io.EOF:
 				break FILE_READING_LOOP
 			default:
-				return nil, fmt.Errorf("failed to read embedded %s: %w\nretrieved record: %v", fileWithTransformedNames, err, record)
+				return fmt.Errorf("failed to read %s: %w\nretrieved record: %v", filename, err, record)
 
 			}
 		}
@@ -60,33 +146,53 @@ FILE_READING_LOOP:
 		year := record[0]
 		yearInt, err := strconv.ParseInt(year, 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse year %s: %w", year, err)
+			return fmt.Errorf("failed to parse year %s in %s: %w", year, filename, err)
 		}
-		yearDB, exists := namesDB.database[yearInt]
+		yearDB, exists := n.database[yearInt]
 		if !exists {
 			yearDB = &YearDB{
-				Entries: make(map[int64]string),
-				maxId:   0,
+				Entries:  make(map[int64]*models.Name),
+				maxId:    0,
+				byGender: make(map[models.Gender][]int64),
 			}
-			namesDB.years[yearInt] = struct{}{}
-			namesDB.database[yearInt] = yearDB
+			n.years[yearInt] = struct{}{}
+			n.database[yearInt] = yearDB
 		}
 		// id
 		id, err := strconv.ParseInt(record[1], 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse id %s: %w", record[0], err)
+			return fmt.Errorf("failed to parse id %s in %s: %w", record[1], filename, err)
+		}
+		if _, exists := yearDB.Entries[id]; exists {
+			return fmt.Errorf("duplicate id %d for year %d in %s", id, yearInt, filename)
 		}
 		// name
 		name := record[2]
+		// gender
+		gender, err := models.ParseGender(record[3])
+		if err != nil {
+			return fmt.Errorf("failed to parse gender %s in %s: %w", record[3], filename, err)
+		}
+		// count
+		count, err := strconv.ParseInt(record[4], 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse count %s in %s: %w", record[4], filename, err)
+		}
 
 		// write entry
-		yearDB.Entries[id] = name
+		yearDB.Entries[id] = &models.Name{
+			Id:     id,
+			Value:  name,
+			Gender: gender,
+			Count:  count,
+		}
 		if id > yearDB.maxId {
 			yearDB.maxId = id
 		}
+		yearDB.byGender[gender] = append(yearDB.byGender[gender], id)
 	}
 
-	return namesDB, nil
+	return nil
 }
 
 func (n NamesDB) GetName(ctx context.Context, year int64, id int64) (*models.Name, error) {
@@ -98,10 +204,8 @@ func (n NamesDB) GetName(ctx context.Context, year int64, id int64) (*models.Nam
 	if !ok {
 		return nil, ErrNameNotFound
 	}
-	return &models.Name{
-		Id:    id,
-		Value: name,
-	}, nil
+	result := *name
+	return &result, nil
 }
 
 func (n NamesDB) GetPage(ctx context.Context, year int64, page int64, limit int64) ([]*models.Name, error) {
@@ -111,8 +215,8 @@ func (n NamesDB) GetPage(ctx context.Context, year int64, page int64, limit int6
 	}
 	start := page * limit
 	end := start + limit
-	if end > yearDB.maxId {
-		end = yearDB.maxId
+	if end > yearDB.maxId+1 {
+		end = yearDB.maxId + 1
 	}
 
 	// generate response
@@ -122,10 +226,8 @@ func (n NamesDB) GetPage(ctx context.Context, year int64, page int64, limit int6
 		if !ok {
 			return nil, fmt.Errorf("name with id %d not found", i)
 		}
-		names = append(names, &models.Name{
-			Id:    i,
-			Value: name,
-		})
+		result := *name
+		names = append(names, &result)
 	}
 
 	return names, nil
@@ -136,5 +238,176 @@ func (n NamesDB) GetYearsAvailable(ctx context.Context) (map[int64]struct{}, err
 }
 
 func (n NamesDB) GetNoOfEntries(ctx context.Context, year int64) (int64, error) {
-	return int64(len(n.database[year].Entries)), nil
+	yearDB, ok := n.database[year]
+	if !ok {
+		return 0, ErrYearNotFound
+	}
+	return int64(len(yearDB.Entries)), nil
+}
+
+func (n NamesDB) GetPageByGender(ctx context.Context, year int64, gender models.Gender, page int64, limit int64) ([]*models.Name, error) {
+	yearDB, ok := n.database[year]
+	if !ok {
+		return nil, ErrYearNotFound
+	}
+	ids := yearDB.byGender[gender]
+	start := page * limit
+	if start > int64(len(ids)) {
+		start = int64(len(ids))
+	}
+	end := start + limit
+	if end > int64(len(ids)) {
+		end = int64(len(ids))
+	}
+
+	// generate response
+	var names []*models.Name
+	for _, id := range ids[start:end] {
+		result := *yearDB.Entries[id]
+		names = append(names, &result)
+	}
+
+	return names, nil
+}
+
+func (n NamesDB) GetNoOfEntriesByGender(ctx context.Context, year int64, gender models.Gender) (int64, error) {
+	yearDB, ok := n.database[year]
+	if !ok {
+		return 0, ErrYearNotFound
+	}
+	return int64(len(yearDB.byGender[gender])), nil
+}
+
+func (n NamesDB) GetPageByQuery(ctx context.Context, year int64, query models.NameQuery, page int64, limit int64) ([]*models.Name, int64, error) {
+	yearDB, ok := n.database[year]
+	if !ok {
+		return nil, 0, ErrYearNotFound
+	}
+	matcher := analysis.NewMatcher(query)
+	var matching []*models.Name
+	for _, id := range yearDB.orderedIds(query.Gender) {
+		if matcher.Matches(yearDB.Entries[id]) {
+			matching = append(matching, yearDB.Entries[id])
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool { return analysis.Less(matching[i], matching[j], query) })
+	total := int64(len(matching))
+	start := page * limit
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+
+	// generate response
+	var names []*models.Name
+	for _, name := range matching[start:end] {
+		result := *name
+		names = append(names, &result)
+	}
+
+	return names, total, nil
+}
+
+func (n NamesDB) Search(ctx context.Context, year int64, query string, limit int64) ([]*models.Name, error) {
+	yearDB, ok := n.database[year]
+	if !ok {
+		return nil, ErrYearNotFound
+	}
+
+	// generate response
+	var names []*models.Name
+	for _, id := range yearDB.search.lookup(query, limit) {
+		result := *yearDB.Entries[id]
+		names = append(names, &result)
+	}
+
+	return names, nil
+}
+
+func (n NamesDB) GetNameHistory(ctx context.Context, value string) ([]*models.NameHistoryEntry, error) {
+	years := make([]int64, 0, len(n.years))
+	for year := range n.years {
+		years = append(years, year)
+	}
+	sort.Slice(years, func(i, j int) bool { return years[i] < years[j] })
+
+	// generate response
+	var history []*models.NameHistoryEntry
+	var found bool
+	key := strings.ToUpper(value)
+	for _, year := range years {
+		ids := n.database[year].byValue[key]
+		if len(ids) == 0 {
+			history = append(history, &models.NameHistoryEntry{Year: year})
+			continue
+		}
+		found = true
+		for _, id := range ids {
+			result := *n.database[year].Entries[id]
+			history = append(history, &models.NameHistoryEntry{
+				Year: year,
+				Name: &result,
+			})
+		}
+	}
+	if !found {
+		return nil, ErrNameNotFound
+	}
+
+	return history, nil
+}
+
+// orderedIds returns ids of entries of a given gender, or of all entries if the gender is empty
+func (y *YearDB) orderedIds(gender models.Gender) []int64 {
+	if gender == "" {
+		return y.ids
+	}
+	return y.byGender[gender]
+}
+
+func (n NamesDB) GetNamesAfter(ctx context.Context, year int64, gender models.Gender, afterId int64, limit int64) ([]*models.Name, error) {
+	yearDB, ok := n.database[year]
+	if !ok {
+		return nil, ErrYearNotFound
+	}
+	ids := yearDB.orderedIds(gender)
+	start := sort.Search(len(ids), func(i int) bool { return ids[i] > afterId })
+	end := start + int(limit)
+	if end > len(ids) {
+		end = len(ids)
+	}
+
+	// generate response
+	var names []*models.Name
+	for _, id := range ids[start:end] {
+		result := *yearDB.Entries[id]
+		names = append(names, &result)
+	}
+
+	return names, nil
+}
+
+func (n NamesDB) GetNamesBefore(ctx context.Context, year int64, gender models.Gender, beforeId int64, limit int64) ([]*models.Name, error) {
+	yearDB, ok := n.database[year]
+	if !ok {
+		return nil, ErrYearNotFound
+	}
+	ids := yearDB.orderedIds(gender)
+	end := sort.Search(len(ids), func(i int) bool { return ids[i] >= beforeId })
+	start := end - int(limit)
+	if start < 0 {
+		start = 0
+	}
+
+	// generate response
+	var names []*models.Name
+	for _, id := range ids[start:end] {
+		result := *yearDB.Entries[id]
+		names = append(names, &result)
+	}
+
+	return names, nil
 }
@@ -0,0 +1,92 @@
+package namesdb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mwasilew2/go-service-template/internal/adapters/namesdb"
+	"github.com/mwasilew2/go-service-template/internal/adapters/namestest"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+func TestGetNoOfEntries(t *testing.T) {
+	namesDB, err := namesdb.NewNamesDB("")
+	if err != nil {
+		t.Fatalf("failed to load embedded dataset: %v", err)
+	}
+	tests := []struct {
+		name    string
+		year    int64
+		want    int64
+		wantErr error
+	}{
+		{name: "available year", year: 2023, want: 1033},
+		{name: "missing year", year: 1999, wantErr: namesdb.ErrYearNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := namesDB.GetNoOfEntries(context.Background(), tt.year)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %d entries, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	namesDB, err := namesdb.NewNamesDB("")
+	if err != nil {
+		t.Fatalf("failed to load embedded dataset: %v", err)
+	}
+	tests := []struct {
+		name    string
+		page    int64
+		limit   int64
+		wantIds []int64
+	}{
+		{name: "first page", page: 0, limit: 3, wantIds: []int64{0, 1, 2}},
+		{name: "last entry", page: 1032, limit: 1, wantIds: []int64{1032}},
+		{name: "partial last page", page: 103, limit: 10, wantIds: []int64{1030, 1031, 1032}},
+		{name: "past the end", page: 1033, limit: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			names, err := namesDB.GetPage(context.Background(), 2023, tt.page, tt.limit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(names) != len(tt.wantIds) {
+				t.Fatalf("expected %d names, got %d", len(tt.wantIds), len(names))
+			}
+			for i, name := range names {
+				if name.Id != tt.wantIds[i] {
+					t.Errorf("expected id %d at position %d, got %d", tt.wantIds[i], i, name.Id)
+				}
+			}
+		})
+	}
+}
+
+func TestNamesDB(t *testing.T) {
+	namestest.Run(t, func(t *testing.T, dataDir string) ports.NamesService {
+		namesDB, err := namesdb.NewNamesDB(dataDir)
+		if err != nil {
+			t.Fatalf("failed to create names database: %v", err)
+		}
+		return namesDB
+	})
+}
+
+func TestReloadableNamesDB(t *testing.T) {
+	namestest.Run(t, func(t *testing.T, dataDir string) ports.NamesService {
+		namesDB, err := namesdb.NewReloadableNamesDB(dataDir)
+		if err != nil {
+			t.Fatalf("failed to create names database: %v", err)
+		}
+		return namesDB
+	})
+}
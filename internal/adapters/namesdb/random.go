@@ -0,0 +1,25 @@
+package namesdb
+
+import (
+	"context"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/sampling"
+)
+
+func (n NamesDB) Random(ctx context.Context, year int64, gender models.Gender, count int64, weighted bool, seed *int64, exclude map[int64]struct{}) ([]*models.Name, error) {
+	yearDB, ok := n.database[year]
+	if !ok {
+		return nil, ErrYearNotFound
+	}
+
+	// generate response
+	var names []*models.Name
+	weight := func(id int64) int64 { return yearDB.Entries[id].Count }
+	for _, id := range sampling.Sample(sampling.NewRand(seed), yearDB.orderedIds(gender), weight, count, weighted, exclude) {
+		result := *yearDB.Entries[id]
+		names = append(names, &result)
+	}
+
+	return names, nil
+}
@@ -0,0 +1,167 @@
+package namesdb
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+// ReloadableNamesDB serves names from a NamesDB which can be rebuilt from the data directory
+// and swapped without interrupting readers. A NamesDB is never modified after it's been loaded,
+// so every reader always sees a consistent dataset.
+type ReloadableNamesDB struct {
+	dataDir string
+	current atomic.Pointer[NamesDB]
+
+	// serializes reloads
+	mu sync.Mutex
+	// fingerprint of the data directory seen during the last reload attempt
+	lastSeen string
+}
+
+type snapshotKey struct{}
+
+func NewReloadableNamesDB(dataDir string) (*ReloadableNamesDB, error) {
+	r := &ReloadableNamesDB{
+		dataDir: dataDir,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload rebuilds the database from the data directory. If loading fails, the previously loaded data is kept.
+func (r *ReloadableNamesDB) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.reload()
+}
+
+// ReloadIfChanged rebuilds the database if any dataset in the data directory was added, removed or modified since
+// the last reload attempt. It reports whether a reload was attempted.
+func (r *ReloadableNamesDB) ReloadIfChanged() (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.dataDir == "" {
+		return false, nil
+	}
+	fingerprint, err := dirFingerprint(r.dataDir)
+	if err != nil {
+		return false, err
+	}
+	if fingerprint == r.lastSeen {
+		return false, nil
+	}
+	return true, r.reload()
+}
+
+func (r *ReloadableNamesDB) reload() error {
+	if r.dataDir != "" {
+		fingerprint, err := dirFingerprint(r.dataDir)
+		if err != nil {
+			return err
+		}
+		// a broken dataset is not retried until it changes again
+		r.lastSeen = fingerprint
+	}
+	namesDB, err := NewNamesDB(r.dataDir)
+	if err != nil {
+		return fmt.Errorf("failed to reload names database: %w", err)
+	}
+	r.current.Store(namesDB)
+	return nil
+}
+
+// WithSnapshot pins the currently loaded database to the context, so that all calls made with the returned
+// context are answered from the same dataset, even if a reload happens in the meantime.
+func (r *ReloadableNamesDB) WithSnapshot(ctx context.Context) context.Context {
+	return context.WithValue(ctx, snapshotKey{}, r.current.Load())
+}
+
+func (r *ReloadableNamesDB) snapshot(ctx context.Context) *NamesDB {
+	if namesDB, ok := ctx.Value(snapshotKey{}).(*NamesDB); ok {
+		return namesDB
+	}
+	return r.current.Load()
+}
+
+func (r *ReloadableNamesDB) GetName(ctx context.Context, year int64, id int64) (*models.Name, error) {
+	return r.snapshot(ctx).GetName(ctx, year, id)
+}
+
+func (r *ReloadableNamesDB) GetPage(ctx context.Context, year int64, page int64, limit int64) ([]*models.Name, error) {
+	return r.snapshot(ctx).GetPage(ctx, year, page, limit)
+}
+
+func (r *ReloadableNamesDB) GetYearsAvailable(ctx context.Context) (map[int64]struct{}, error) {
+	return r.snapshot(ctx).GetYearsAvailable(ctx)
+}
+
+func (r *ReloadableNamesDB) GetNoOfEntries(ctx context.Context, year int64) (int64, error) {
+	return r.snapshot(ctx).GetNoOfEntries(ctx, year)
+}
+
+func (r *ReloadableNamesDB) GetPageByGender(ctx context.Context, year int64, gender models.Gender, page int64, limit int64) ([]*models.Name, error) {
+	return r.snapshot(ctx).GetPageByGender(ctx, year, gender, page, limit)
+}
+
+func (r *ReloadableNamesDB) GetNoOfEntriesByGender(ctx context.Context, year int64, gender models.Gender) (int64, error) {
+	return r.snapshot(ctx).GetNoOfEntriesByGender(ctx, year, gender)
+}
+
+func (r *ReloadableNamesDB) Search(ctx context.Context, year int64, query string, limit int64) ([]*models.Name, error) {
+	return r.snapshot(ctx).Search(ctx, year, query, limit)
+}
+
+func (r *ReloadableNamesDB) GetNameHistory(ctx context.Context, value string) ([]*models.NameHistoryEntry, error) {
+	return r.snapshot(ctx).GetNameHistory(ctx, value)
+}
+
+func (r *ReloadableNamesDB) GetPageByQuery(ctx context.Context, year int64, query models.NameQuery, page int64, limit int64) ([]*models.Name, int64, error) {
+	return r.snapshot(ctx).GetPageByQuery(ctx, year, query, page, limit)
+}
+
+func (r *ReloadableNamesDB) GetNamesAfter(ctx context.Context, year int64, gender models.Gender, afterId int64, limit int64) ([]*models.Name, error) {
+	return r.snapshot(ctx).GetNamesAfter(ctx, year, gender, afterId, limit)
+}
+
+func (r *ReloadableNamesDB) GetNamesBefore(ctx context.Context, year int64, gender models.Gender, beforeId int64, limit int64) ([]*models.Name, error) {
+	return r.snapshot(ctx).GetNamesBefore(ctx, year, gender, beforeId, limit)
+}
+
+func (r *ReloadableNamesDB) Random(ctx context.Context, year int64, gender models.Gender, count int64, weighted bool, seed *int64, exclude map[int64]struct{}) ([]*models.Name, error) {
+	return r.snapshot(ctx).Random(ctx, year, gender, count, weighted, seed, exclude)
+}
+
+func (r *ReloadableNamesDB) GetSimilar(ctx context.Context, year int64, id int64, limit int64) ([]*models.SimilarName, error) {
+	return r.snapshot(ctx).GetSimilar(ctx, year, id, limit)
+}
+
+// dirFingerprint describes names, sizes and modification times of all datasets and metadata in a directory
+func dirFingerprint(dataDir string) (string, error) {
+	dirFS := os.DirFS(dataDir)
+	files, err := fs.Glob(dirFS, "*.csv")
+	if err != nil {
+		return "", fmt.Errorf("failed to list datasets in %s: %w", dataDir, err)
+	}
+	// metadata is reloaded together with the datasets
+	if _, err := fs.Stat(dirFS, fileWithMetadata); err == nil {
+		files = append(files, fileWithMetadata)
+	}
+	var fingerprint string
+	for _, file := range files {
+		info, err := fs.Stat(dirFS, file)
+		if err != nil {
+			return "", fmt.Errorf("failed to stat %s: %w", file, err)
+		}
+		fingerprint += fmt.Sprintf("%s:%d:%s;", file, info.Size(), info.ModTime().Format(time.RFC3339Nano))
+	}
+	return fingerprint, nil
+}
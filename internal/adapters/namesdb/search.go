@@ -0,0 +1,81 @@
+package namesdb
+
+import (
+	"index/suffixarray"
+	"sort"
+	"strings"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/normalize"
+)
+
+// separates names in the indexed data, folded names never contain it
+const searchSeparator = "\x00"
+
+// searchIndex answers prefix and substring queries over folded names of a single year
+type searchIndex struct {
+	index *suffixarray.Index
+	// offsets of the first byte of each name in the indexed data, ascending
+	offsets []int
+	// ids of the names, in the same order as offsets
+	ids []int64
+}
+
+// newSearchIndex indexes names, ids have to be given in ascending order
+func newSearchIndex(entries Entries, ids []int64) *searchIndex {
+	s := &searchIndex{
+		offsets: make([]int, 0, len(ids)),
+		ids:     make([]int64, 0, len(ids)),
+	}
+	var data []byte
+	for _, id := range ids {
+		data = append(data, searchSeparator...)
+		s.offsets = append(s.offsets, len(data))
+		s.ids = append(s.ids, id)
+		data = append(data, normalize.Fold(entries[id].Value)...)
+	}
+	s.index = suffixarray.New(data)
+	return s
+}
+
+// lookup returns ids of names matching a query, names starting with the query come first, otherwise names are
+// ordered by id
+func (s *searchIndex) lookup(query string, limit int64) []int64 {
+	folded := normalize.Fold(query)
+	if folded == "" || strings.Contains(folded, searchSeparator) {
+		return nil
+	}
+
+	prefixMatches := make(map[int64]struct{})
+	substringMatches := make(map[int64]struct{})
+	for _, pos := range s.index.Lookup([]byte(folded), -1) {
+		i := sort.SearchInts(s.offsets, pos+1) - 1
+		if i < 0 {
+			continue
+		}
+		if pos == s.offsets[i] {
+			prefixMatches[s.ids[i]] = struct{}{}
+		} else {
+			substringMatches[s.ids[i]] = struct{}{}
+		}
+	}
+
+	// names starting with the query could also contain it further on
+	for id := range prefixMatches {
+		delete(substringMatches, id)
+	}
+
+	result := append(sortedIds(prefixMatches), sortedIds(substringMatches)...)
+	if int64(len(result)) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+func sortedIds(set map[int64]struct{}) []int64 {
+	ids := make([]int64, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -0,0 +1,59 @@
+package namesdb
+
+import (
+	"context"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/normalize"
+	"github.com/mwasilew2/go-service-template/internal/domain/phonetic"
+)
+
+// soundIndex keeps phonetic codes and folded values of names of a single year, so that they're computed only once
+type soundIndex struct {
+	codes  map[int64]string
+	folded map[int64]string
+}
+
+func newSoundIndex(entries Entries, ids []int64) *soundIndex {
+	s := &soundIndex{
+		codes:  make(map[int64]string, len(ids)),
+		folded: make(map[int64]string, len(ids)),
+	}
+	for _, id := range ids {
+		s.codes[id] = phonetic.Encode(entries[id].Value)
+		s.folded[id] = normalize.Fold(entries[id].Value)
+	}
+	return s
+}
+
+func (n NamesDB) GetSimilar(ctx context.Context, year int64, id int64, limit int64) ([]*models.SimilarName, error) {
+	yearDB, ok := n.database[year]
+	if !ok {
+		return nil, ErrYearNotFound
+	}
+	name, ok := yearDB.Entries[id]
+	if !ok {
+		return nil, ErrNameNotFound
+	}
+	code, folded := yearDB.sounds.codes[id], yearDB.sounds.folded[id]
+
+	result := []*models.SimilarName{}
+	for _, candidateId := range yearDB.byGender[name.Gender] {
+		if candidateId == id {
+			continue
+		}
+		similar, ok := phonetic.Compare(code, folded, yearDB.sounds.codes[candidateId], yearDB.sounds.folded[candidateId])
+		if !ok {
+			continue
+		}
+		candidate := *yearDB.Entries[candidateId]
+		similar.Name = &candidate
+		result = append(result, similar)
+	}
+
+	phonetic.SortSimilar(result)
+	if int64(len(result)) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
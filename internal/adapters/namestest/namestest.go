@@ -0,0 +1,462 @@
+// Package namestest verifies that adapters of ports.NamesService behave alike. Run loads a canonical fixture into an
+// adapter and checks how it handles edge cases, e.g. missing years and ids, page boundaries and years without names of
+// a gender, so that every adapter can be verified with the same table of assertions as NamesDB.
+package namestest
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+//go:embed fixture
+var fixture embed.FS
+
+// Years of the fixture, the first one has only male names
+const (
+	FirstYear  int64 = 2021
+	SecondYear int64 = 2022
+	// MissingYear isn't in the fixture
+	MissingYear int64 = 1999
+)
+
+// Constructor returns an adapter serving datasets found in dataDir, in the format read by namesdb.NewNamesDB. It
+// should fail the test if the adapter can't be created and register cleanup of the adapter with t.Cleanup.
+type Constructor func(t *testing.T, dataDir string) ports.NamesService
+
+// WriteFixture copies the fixture, a dataset and metadata of names, into a temporary directory and returns it
+func WriteFixture(t *testing.T) string {
+	t.Helper()
+	dataDir := t.TempDir()
+	files, err := fs.Glob(fixture, "fixture/*")
+	if err != nil {
+		t.Fatalf("failed to list fixture: %v", err)
+	}
+	for _, file := range files {
+		data, err := fs.ReadFile(fixture, file)
+		if err != nil {
+			t.Fatalf("failed to read fixture %s: %v", file, err)
+		}
+		if err := os.WriteFile(filepath.Join(dataDir, filepath.Base(file)), data, 0o644); err != nil {
+			t.Fatalf("failed to write fixture %s: %v", file, err)
+		}
+	}
+	return dataDir
+}
+
+// Run creates an adapter from the fixture and runs every case against it
+func Run(t *testing.T, newService Constructor) {
+	service := newService(t, WriteFixture(t))
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			c.check(t, context.Background(), service)
+		})
+	}
+}
+
+var cases = []struct {
+	name  string
+	check func(t *testing.T, ctx context.Context, s ports.NamesService)
+}{
+	// years
+	{"years available", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		years, err := s.GetYearsAvailable(ctx)
+		expectNoError(t, err)
+		if len(years) != 2 {
+			t.Errorf("expected 2 years, got %v", years)
+		}
+		for _, year := range []int64{FirstYear, SecondYear} {
+			if _, ok := years[year]; !ok {
+				t.Errorf("expected year %d to be available, got %v", year, years)
+			}
+		}
+	}},
+
+	// single names
+	{"name", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		name, err := s.GetName(ctx, SecondYear, 3)
+		expectNoError(t, err)
+		expectName(t, name, &models.Name{Id: 3, Value: "ŁUKASZ", Gender: models.GenderMale, Count: 300, Rank: 2})
+		if name.Traits.Length != 6 || name.Traits.Initial != "Ł" || name.Traits.Ending != "Z" {
+			t.Errorf("expected traits of ŁUKASZ, got %+v", name.Traits)
+		}
+		if name.Meta == nil || name.Meta.Origin != "Greek" {
+			t.Errorf("expected metadata of ŁUKASZ, got %+v", name.Meta)
+		}
+	}},
+	{"name without metadata", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		name, err := s.GetName(ctx, SecondYear, 8)
+		expectNoError(t, err)
+		if name.Meta != nil {
+			t.Errorf("expected no metadata of MIKOŁAJ, got %+v", name.Meta)
+		}
+	}},
+	{"equally popular names share a rank", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		for id, rank := range map[int64]int64{0: 1, 2: 2, 3: 2, 8: 4, 1: 1, 6: 4} {
+			name, err := s.GetName(ctx, SecondYear, id)
+			expectNoError(t, err)
+			if name.Rank != rank {
+				t.Errorf("expected rank %d of %s, got %d", rank, name.Value, name.Rank)
+			}
+		}
+	}},
+	{"name with a missing id", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetName(ctx, SecondYear, 10)
+		expectError(t, err, ports.ErrNameNotFound)
+		_, err = s.GetName(ctx, SecondYear, -1)
+		expectError(t, err, ports.ErrNameNotFound)
+	}},
+	{"name from a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetName(ctx, MissingYear, 0)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+
+	// pages
+	{"first page", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.GetPage(ctx, SecondYear, 0, 4)
+		expectIds(t, names, err, 0, 1, 2, 3)
+	}},
+	{"last page is partial", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.GetPage(ctx, SecondYear, 2, 4)
+		expectIds(t, names, err, 8, 9)
+	}},
+	{"page past the end is empty", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.GetPage(ctx, SecondYear, 3, 4)
+		expectIds(t, names, err)
+	}},
+	{"page from a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetPage(ctx, MissingYear, 0, 4)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+	{"number of entries", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		count, err := s.GetNoOfEntries(ctx, SecondYear)
+		expectCount(t, count, err, 10)
+		count, err = s.GetNoOfEntries(ctx, FirstYear)
+		expectCount(t, count, err, 5)
+	}},
+	{"number of entries in a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetNoOfEntries(ctx, MissingYear)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+
+	// pages by gender
+	{"pages by gender", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.GetPageByGender(ctx, SecondYear, models.GenderFemale, 0, 3)
+		expectIds(t, names, err, 1, 4, 6)
+		names, err = s.GetPageByGender(ctx, SecondYear, models.GenderFemale, 1, 3)
+		expectIds(t, names, err, 7)
+		names, err = s.GetPageByGender(ctx, SecondYear, models.GenderFemale, 2, 3)
+		expectIds(t, names, err)
+	}},
+	{"page by gender from a year without names of the gender", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.GetPageByGender(ctx, FirstYear, models.GenderFemale, 0, 10)
+		expectIds(t, names, err)
+		count, err := s.GetNoOfEntriesByGender(ctx, FirstYear, models.GenderFemale)
+		expectCount(t, count, err, 0)
+	}},
+	{"page by gender from a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetPageByGender(ctx, MissingYear, models.GenderMale, 0, 3)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+	{"number of entries by gender", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		count, err := s.GetNoOfEntriesByGender(ctx, SecondYear, models.GenderFemale)
+		expectCount(t, count, err, 4)
+		count, err = s.GetNoOfEntriesByGender(ctx, SecondYear, models.GenderMale)
+		expectCount(t, count, err, 6)
+	}},
+	{"number of entries by gender in a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetNoOfEntriesByGender(ctx, MissingYear, models.GenderMale)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+
+	// queries
+	{"query without filters", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, total, err := s.GetPageByQuery(ctx, SecondYear, models.NameQuery{}, 0, 3)
+		expectIds(t, names, err, 0, 1, 2)
+		expectCount(t, total, err, 10)
+	}},
+	{"query sorted descending breaks ties by id descending", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		query := models.NameQuery{Gender: models.GenderMale, SortBy: models.SortByCount, Descending: true}
+		names, total, err := s.GetPageByQuery(ctx, SecondYear, query, 0, 3)
+		expectIds(t, names, err, 0, 3, 2)
+		expectCount(t, total, err, 6)
+	}},
+	{"query by origin ignores case", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		query := models.NameQuery{Origin: "greek", SortBy: models.SortByName}
+		names, total, err := s.GetPageByQuery(ctx, SecondYear, query, 0, 10)
+		expectIds(t, names, err, 5, 6, 1, 3)
+		expectCount(t, total, err, 4)
+	}},
+	{"query by traits ignores diacritics", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		query := models.NameQuery{MinLength: 6, Initial: "l"}
+		names, total, err := s.GetPageByQuery(ctx, SecondYear, query, 0, 10)
+		expectIds(t, names, err, 3)
+		expectCount(t, total, err, 1)
+	}},
+	{"last page of a query is partial", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		query := models.NameQuery{Gender: models.GenderFemale, Ending: "a"}
+		names, total, err := s.GetPageByQuery(ctx, SecondYear, query, 1, 2)
+		expectIds(t, names, err, 7)
+		expectCount(t, total, err, 3)
+	}},
+	{"page of a query past the end is empty", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, total, err := s.GetPageByQuery(ctx, SecondYear, models.NameQuery{}, 5, 3)
+		expectIds(t, names, err)
+		expectCount(t, total, err, 10)
+	}},
+	{"query in a year without names of the gender", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, total, err := s.GetPageByQuery(ctx, FirstYear, models.NameQuery{Gender: models.GenderFemale}, 0, 10)
+		expectIds(t, names, err)
+		expectCount(t, total, err, 0)
+	}},
+	{"query in a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, _, err := s.GetPageByQuery(ctx, MissingYear, models.NameQuery{}, 0, 3)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+
+	// cursors
+	{"names after an id", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.GetNamesAfter(ctx, SecondYear, "", -1, 3)
+		expectIds(t, names, err, 0, 1, 2)
+		names, err = s.GetNamesAfter(ctx, SecondYear, "", 8, 5)
+		expectIds(t, names, err, 9)
+		names, err = s.GetNamesAfter(ctx, SecondYear, models.GenderFemale, 1, 2)
+		expectIds(t, names, err, 4, 6)
+	}},
+	{"names after the last id", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.GetNamesAfter(ctx, SecondYear, "", 9, 5)
+		expectIds(t, names, err)
+	}},
+	{"names after an id in a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetNamesAfter(ctx, MissingYear, "", -1, 3)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+	{"names before an id", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.GetNamesBefore(ctx, SecondYear, "", 10, 3)
+		expectIds(t, names, err, 7, 8, 9)
+		names, err = s.GetNamesBefore(ctx, SecondYear, "", 2, 5)
+		expectIds(t, names, err, 0, 1)
+		names, err = s.GetNamesBefore(ctx, SecondYear, models.GenderMale, 5, 10)
+		expectIds(t, names, err, 0, 2, 3)
+	}},
+	{"names before the first id", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.GetNamesBefore(ctx, SecondYear, "", 0, 3)
+		expectIds(t, names, err)
+	}},
+	{"names before an id in a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetNamesBefore(ctx, MissingYear, "", 10, 3)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+
+	// search
+	{"search ignores case and diacritics", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.Search(ctx, SecondYear, "luk", 10)
+		expectIds(t, names, err, 3, 9)
+		names, err = s.Search(ctx, SecondYear, "Łuk", 10)
+		expectIds(t, names, err, 3, 9)
+	}},
+	{"search puts prefix matches first", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.Search(ctx, SecondYear, "an", 10)
+		expectIds(t, names, err, 0, 2, 4)
+		names, err = s.Search(ctx, SecondYear, "a", 3)
+		expectIds(t, names, err, 0, 5, 6)
+	}},
+	{"search for an empty query", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.Search(ctx, SecondYear, " ", 10)
+		expectIds(t, names, err)
+	}},
+	{"search in a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.Search(ctx, MissingYear, "jan", 10)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+
+	// history
+	{"history has an entry for every year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		history, err := s.GetNameHistory(ctx, "zofia")
+		expectNoError(t, err)
+		expectHistory(t, history, "2021:- 2022:1")
+	}},
+	{"history has an entry for every gender", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		history, err := s.GetNameHistory(ctx, "ALEX")
+		expectNoError(t, err)
+		expectHistory(t, history, "2021:3 2022:5 2022:6")
+	}},
+	{"history ignores case but not diacritics", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		history, err := s.GetNameHistory(ctx, "łukasz")
+		expectNoError(t, err)
+		expectHistory(t, history, "2021:- 2022:3")
+		_, err = s.GetNameHistory(ctx, "lukasz")
+		expectError(t, err, ports.ErrNameNotFound)
+	}},
+	{"history of a missing name", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetNameHistory(ctx, "NOPE")
+		expectError(t, err, ports.ErrNameNotFound)
+	}},
+
+	// random names
+	{"random names with the same seed are the same", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		for _, weighted := range []bool{false, true} {
+			seed := int64(42)
+			first, err := s.Random(ctx, SecondYear, "", 3, weighted, &seed, nil)
+			expectNoError(t, err)
+			second, err := s.Random(ctx, SecondYear, "", 3, weighted, &seed, nil)
+			expectIds(t, second, err, ids(first)...)
+			expectDistinct(t, first, 3)
+		}
+	}},
+	{"random names are limited by the number of names", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.Random(ctx, SecondYear, "", 100, false, nil, nil)
+		expectNoError(t, err)
+		expectDistinct(t, names, 10)
+		names, err = s.Random(ctx, SecondYear, models.GenderFemale, 100, true, nil, nil)
+		expectNoError(t, err)
+		expectDistinct(t, names, 4)
+		for _, name := range names {
+			if name.Gender != models.GenderFemale {
+				t.Errorf("expected only female names, got %s", name.Value)
+			}
+		}
+	}},
+	{"random names skip excluded ones", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		exclude := map[int64]struct{}{0: {}, 1: {}, 2: {}}
+		names, err := s.Random(ctx, SecondYear, "", 100, false, nil, exclude)
+		expectNoError(t, err)
+		expectDistinct(t, names, 7)
+		for _, name := range names {
+			if _, ok := exclude[name.Id]; ok {
+				t.Errorf("expected excluded name %d to be skipped", name.Id)
+			}
+		}
+	}},
+	{"random names from a year without names of the gender", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		names, err := s.Random(ctx, FirstYear, models.GenderFemale, 3, false, nil, nil)
+		expectIds(t, names, err)
+	}},
+	{"random names from a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.Random(ctx, MissingYear, "", 3, false, nil, nil)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+
+	// similar names
+	{"similar names", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		similar, err := s.GetSimilar(ctx, SecondYear, 3, 10)
+		expectNoError(t, err)
+		if len(similar) != 1 || similar[0].Name.Id != 9 {
+			t.Fatalf("expected LUKAS to be the only name similar to ŁUKASZ, got %v", similarIds(similar))
+		}
+		if similar[0].PhoneticDistance != 1 || similar[0].EditDistance != 1 {
+			t.Errorf("expected distances 1 and 1, got %d and %d", similar[0].PhoneticDistance, similar[0].EditDistance)
+		}
+	}},
+	{"similar names are limited", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		similar, err := s.GetSimilar(ctx, SecondYear, 3, 0)
+		expectNoError(t, err)
+		if len(similar) != 0 {
+			t.Errorf("expected no similar names, got %v", similarIds(similar))
+		}
+	}},
+	{"similar names of a missing id", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetSimilar(ctx, SecondYear, 10, 10)
+		expectError(t, err, ports.ErrNameNotFound)
+	}},
+	{"similar names from a missing year", func(t *testing.T, ctx context.Context, s ports.NamesService) {
+		_, err := s.GetSimilar(ctx, MissingYear, 0, 10)
+		expectError(t, err, ports.ErrYearNotFound)
+	}},
+}
+
+func expectNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func expectError(t *testing.T, err error, want error) {
+	t.Helper()
+	if !errors.Is(err, want) {
+		t.Fatalf("expected error %q, got %v", want, err)
+	}
+}
+
+func expectCount(t *testing.T, count int64, err error, want int64) {
+	t.Helper()
+	expectNoError(t, err)
+	if count != want {
+		t.Errorf("expected %d, got %d", want, count)
+	}
+}
+
+// expectIds checks ids of names and their order, nil and empty results are equivalent
+func expectIds(t *testing.T, names []*models.Name, err error, want ...int64) {
+	t.Helper()
+	expectNoError(t, err)
+	got := ids(names)
+	if fmt.Sprint(got) != fmt.Sprint(want) && !(len(got) == 0 && len(want) == 0) {
+		t.Errorf("expected ids %v, got %v", want, got)
+	}
+}
+
+func expectName(t *testing.T, got *models.Name, want *models.Name) {
+	t.Helper()
+	if got.Id != want.Id || got.Value != want.Value || got.Gender != want.Gender || got.Count != want.Count || got.Rank != want.Rank {
+		t.Errorf("expected %d %s %s %d rank %d, got %d %s %s %d rank %d",
+			want.Id, want.Value, want.Gender, want.Count, want.Rank,
+			got.Id, got.Value, got.Gender, got.Count, got.Rank)
+	}
+}
+
+func expectDistinct(t *testing.T, names []*models.Name, want int) {
+	t.Helper()
+	seen := make(map[int64]struct{}, len(names))
+	for _, name := range names {
+		seen[name.Id] = struct{}{}
+	}
+	if len(names) != want || len(seen) != want {
+		t.Errorf("expected %d distinct names, got %v", want, ids(names))
+	}
+}
+
+// expectHistory checks years and ids of history entries, formatted as year:id, or year:- for entries without a name
+func expectHistory(t *testing.T, history []*models.NameHistoryEntry, want string) {
+	t.Helper()
+	var got string
+	for i, entry := range history {
+		if i > 0 {
+			got += " "
+		}
+		if entry.Name == nil {
+			got += fmt.Sprintf("%d:-", entry.Year)
+			continue
+		}
+		got += fmt.Sprintf("%d:%d", entry.Year, entry.Name.Id)
+	}
+	if got != want {
+		t.Errorf("expected history %q, got %q", want, got)
+	}
+}
+
+func ids(names []*models.Name) []int64 {
+	result := make([]int64, 0, len(names))
+	for _, name := range names {
+		result = append(result, name.Id)
+	}
+	return result
+}
+
+func similarIds(similar []*models.SimilarName) []int64 {
+	result := make([]int64, 0, len(similar))
+	for _, s := range similar {
+		result = append(result, s.Name.Id)
+	}
+	return result
+}
@@ -0,0 +1,191 @@
+package sessionsdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mwasilew2/go-service-template/internal/adapters/localstate"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+var ErrSessionNotFound = ports.ErrSessionNotFound
+
+// sessionsSubdir is the directory in the state directory where sessions are stored, a file per session
+var sessionsSubdir = "sessions"
+
+type sessionRecord struct {
+	CreatedAt    time.Time           `json:"createdAt"`
+	Year         int64               `json:"year"`
+	Gender       models.Gender       `json:"gender,omitempty"`
+	Participants []participantRecord `json:"participants"`
+}
+
+type participantRecord struct {
+	Token string       `json:"token"`
+	Votes []voteRecord `json:"votes"`
+}
+
+type voteRecord struct {
+	Value   string        `json:"value"`
+	Gender  models.Gender `json:"gender"`
+	Like    bool          `json:"like"`
+	VotedAt time.Time     `json:"votedAt"`
+}
+
+// SessionsDB keeps sessions in memory and writes a session to its own JSON file in a state directory after every
+// change, so that a vote doesn't rewrite all sessions
+type SessionsDB struct {
+	dir string
+
+	mu       sync.Mutex
+	sessions map[string]*sessionRecord
+}
+
+// NewSessionsDB loads sessions stored in stateDir, the directory is created if it doesn't exist
+func NewSessionsDB(stateDir string) (*SessionsDB, error) {
+	dir := filepath.Join(stateDir, sessionsSubdir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create sessions directory %s: %w", dir, err)
+	}
+	sessionsDB := &SessionsDB{
+		dir:      dir,
+		sessions: make(map[string]*sessionRecord),
+	}
+
+	dirFS := os.DirFS(dir)
+	files, err := fs.Glob(dirFS, "*.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions in %s: %w", dir, err)
+	}
+	for _, file := range files {
+		data, err := fs.ReadFile(dirFS, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read session %s: %w", file, err)
+		}
+		var record sessionRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("failed to parse session %s: %w", file, err)
+		}
+		sessionsDB.sessions[strings.TrimSuffix(file, ".json")] = &record
+	}
+
+	return sessionsDB, nil
+}
+
+func (s *SessionsDB) CreateSession(ctx context.Context, year int64, gender models.Gender, participants int) (*models.Session, error) {
+	id, err := localstate.NewId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate session id: %w", err)
+	}
+	record := &sessionRecord{
+		CreatedAt: time.Now().UTC(),
+		Year:      year,
+		Gender:    gender,
+	}
+	for i := 0; i < participants; i++ {
+		token, err := localstate.NewId()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate invite token: %w", err)
+		}
+		record.Participants = append(record.Participants, participantRecord{
+			Token: token,
+			Votes: []voteRecord{},
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.save(id, record); err != nil {
+		return nil, err
+	}
+	s.sessions[id] = record
+
+	return toSession(id, record), nil
+}
+
+func (s *SessionsDB) GetSession(ctx context.Context, id string) (*models.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record, ok := s.sessions[id]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+
+	return toSession(id, record), nil
+}
+
+func (s *SessionsDB) RecordVote(ctx context.Context, id string, participant int, vote *models.Vote) (*models.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record, ok := s.sessions[id]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	if participant < 0 || participant >= len(record.Participants) {
+		return nil, fmt.Errorf("session %s has no participant %d", id, participant)
+	}
+
+	// records are never modified in place, so that a failed save leaves the session as it was
+	updated := *record
+	updated.Participants = append([]participantRecord(nil), record.Participants...)
+	votes := []voteRecord{}
+	for _, existing := range record.Participants[participant].Votes {
+		if strings.EqualFold(existing.Value, vote.Value) && existing.Gender == vote.Gender {
+			continue
+		}
+		votes = append(votes, existing)
+	}
+	updated.Participants[participant].Votes = append(votes, voteRecord{
+		Value:   vote.Value,
+		Gender:  vote.Gender,
+		Like:    vote.Like,
+		VotedAt: vote.VotedAt,
+	})
+	if err := s.save(id, &updated); err != nil {
+		return nil, err
+	}
+	s.sessions[id] = &updated
+
+	return toSession(id, &updated), nil
+}
+
+func (s *SessionsDB) save(id string, record *sessionRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session %s: %w", id, err)
+	}
+	return localstate.WriteFile(filepath.Join(s.dir, id+".json"), data)
+}
+
+func toSession(id string, record *sessionRecord) *models.Session {
+	session := &models.Session{
+		Id:        id,
+		CreatedAt: record.CreatedAt,
+		Year:      record.Year,
+		Gender:    record.Gender,
+	}
+	for _, participant := range record.Participants {
+		output := &models.Participant{
+			Token: participant.Token,
+			Votes: make([]*models.Vote, 0, len(participant.Votes)),
+		}
+		for _, vote := range participant.Votes {
+			output.Votes = append(output.Votes, &models.Vote{
+				Value:   vote.Value,
+				Gender:  vote.Gender,
+				Like:    vote.Like,
+				VotedAt: vote.VotedAt,
+			})
+		}
+		session.Participants = append(session.Participants, output)
+	}
+	return session
+}
@@ -0,0 +1,27 @@
+-- names from all datasets, a row per name, year and gender, with everything NamesDB computes while loading datasets
+CREATE TABLE names (
+    year      INTEGER NOT NULL,
+    id        INTEGER NOT NULL,
+    value     TEXT    NOT NULL,
+    gender    TEXT    NOT NULL,
+    count     INTEGER NOT NULL,
+    rank      INTEGER NOT NULL,
+    -- upper-cased value, names are looked up by value ignoring case
+    value_key TEXT    NOT NULL,
+    -- value without diacritics, lower-cased, searches and filters ignore case and diacritics
+    folded    TEXT    NOT NULL,
+    length    INTEGER NOT NULL,
+    syllables INTEGER NOT NULL,
+    initial   TEXT    NOT NULL,
+    ending    TEXT    NOT NULL,
+    pattern   TEXT    NOT NULL,
+    phonetic  TEXT    NOT NULL,
+    -- meaning and origin, NULL if they're not known
+    origin    TEXT,
+    etymology TEXT,
+    meaning   TEXT,
+    PRIMARY KEY (year, id)
+);
+
+CREATE INDEX names_by_gender ON names (year, gender, id);
+CREATE INDEX names_by_value ON names (value_key, year, id);
@@ -0,0 +1,339 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/analysis"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/normalize"
+	"github.com/mwasilew2/go-service-template/internal/domain/phonetic"
+	"github.com/mwasilew2/go-service-template/internal/domain/sampling"
+)
+
+func (s *SQLiteNamesDB) GetName(ctx context.Context, year int64, id int64) (*models.Name, error) {
+	if err := s.checkYear(ctx, year); err != nil {
+		return nil, err
+	}
+	row := s.db.QueryRowContext(ctx, `SELECT `+nameColumns+` FROM names WHERE year = ? AND id = ?`, year, id)
+	name, err := scanName(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNameNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get name %d from %d: %w", id, year, err)
+	}
+	return name, nil
+}
+
+func (s *SQLiteNamesDB) GetPage(ctx context.Context, year int64, page int64, limit int64) ([]*models.Name, error) {
+	var maxId sql.NullInt64
+	if err := s.db.QueryRowContext(ctx, `SELECT MAX(id) FROM names WHERE year = ?`, year).Scan(&maxId); err != nil {
+		return nil, fmt.Errorf("failed to get the last id from %d: %w", year, err)
+	}
+	if !maxId.Valid {
+		return nil, ErrYearNotFound
+	}
+	start := page * limit
+	end := start + limit
+	if end > maxId.Int64+1 {
+		end = maxId.Int64 + 1
+	}
+
+	names, err := s.queryNames(ctx, `SELECT `+nameColumns+` FROM names WHERE year = ? AND id >= ? AND id < ? ORDER BY id`, year, start, end)
+	if err != nil {
+		return nil, err
+	}
+	// pages are ranges of ids, just like in NamesDB, so a gap in ids is an error
+	for i, name := range names {
+		if name.Id != start+int64(i) {
+			return nil, fmt.Errorf("name with id %d not found", start+int64(i))
+		}
+	}
+	if int64(len(names)) < end-start {
+		return nil, fmt.Errorf("name with id %d not found", start+int64(len(names)))
+	}
+	return names, nil
+}
+
+func (s *SQLiteNamesDB) GetYearsAvailable(ctx context.Context) (map[int64]struct{}, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT DISTINCT year FROM names`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query years: %w", err)
+	}
+	defer rows.Close()
+	years := make(map[int64]struct{})
+	for rows.Next() {
+		var year int64
+		if err := rows.Scan(&year); err != nil {
+			return nil, fmt.Errorf("failed to read year: %w", err)
+		}
+		years[year] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read years: %w", err)
+	}
+	return years, nil
+}
+
+func (s *SQLiteNamesDB) GetNoOfEntries(ctx context.Context, year int64) (int64, error) {
+	var count int64
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM names WHERE year = ?`, year).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count names from %d: %w", year, err)
+	}
+	if count == 0 {
+		return 0, ErrYearNotFound
+	}
+	return count, nil
+}
+
+func (s *SQLiteNamesDB) GetPageByGender(ctx context.Context, year int64, gender models.Gender, page int64, limit int64) ([]*models.Name, error) {
+	if err := s.checkYear(ctx, year); err != nil {
+		return nil, err
+	}
+	return s.queryNames(ctx, `SELECT `+nameColumns+` FROM names WHERE year = ? AND gender = ? ORDER BY id LIMIT ? OFFSET ?`,
+		year, string(gender), limit, page*limit)
+}
+
+func (s *SQLiteNamesDB) GetNoOfEntriesByGender(ctx context.Context, year int64, gender models.Gender) (int64, error) {
+	if err := s.checkYear(ctx, year); err != nil {
+		return 0, err
+	}
+	var count int64
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM names WHERE year = ? AND gender = ?`, year, string(gender)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count names from %d: %w", year, err)
+	}
+	return count, nil
+}
+
+// genderNames returns names of a given gender, or of all genders if the gender is empty, ordered by id
+func (s *SQLiteNamesDB) genderNames(ctx context.Context, year int64, gender models.Gender) ([]*models.Name, error) {
+	if gender == "" {
+		return s.queryNames(ctx, `SELECT `+nameColumns+` FROM names WHERE year = ? ORDER BY id`, year)
+	}
+	return s.queryNames(ctx, `SELECT `+nameColumns+` FROM names WHERE year = ? AND gender = ? ORDER BY id`, year, string(gender))
+}
+
+func (s *SQLiteNamesDB) GetPageByQuery(ctx context.Context, year int64, query models.NameQuery, page int64, limit int64) ([]*models.Name, int64, error) {
+	if err := s.checkYear(ctx, year); err != nil {
+		return nil, 0, err
+	}
+	candidates, err := s.genderNames(ctx, year, query.Gender)
+	if err != nil {
+		return nil, 0, err
+	}
+	// names are filtered and sorted by the analysis package, so that both adapters agree on what matches a query
+	matcher := analysis.NewMatcher(query)
+	var matching []*models.Name
+	for _, name := range candidates {
+		if matcher.Matches(name) {
+			matching = append(matching, name)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool { return analysis.Less(matching[i], matching[j], query) })
+	total := int64(len(matching))
+	start := page * limit
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+
+	var names []*models.Name
+	names = append(names, matching[start:end]...)
+	return names, total, nil
+}
+
+func (s *SQLiteNamesDB) Search(ctx context.Context, year int64, query string, limit int64) ([]*models.Name, error) {
+	if err := s.checkYear(ctx, year); err != nil {
+		return nil, err
+	}
+	folded := normalize.Fold(query)
+	if folded == "" {
+		return nil, nil
+	}
+	// names starting with the query come first, otherwise names are ordered by id
+	return s.queryNames(ctx, `SELECT `+nameColumns+` FROM names
+		WHERE year = ? AND instr(folded, ?) > 0
+		ORDER BY instr(folded, ?) <> 1, id
+		LIMIT ?`, year, folded, folded, limit)
+}
+
+func (s *SQLiteNamesDB) GetNameHistory(ctx context.Context, value string) ([]*models.NameHistoryEntry, error) {
+	years, err := s.GetYearsAvailable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sortedYears := make([]int64, 0, len(years))
+	for year := range years {
+		sortedYears = append(sortedYears, year)
+	}
+	sort.Slice(sortedYears, func(i, j int) bool { return sortedYears[i] < sortedYears[j] })
+
+	rows, err := s.db.QueryContext(ctx, `SELECT year, `+nameColumns+` FROM names WHERE value_key = ? ORDER BY year, id`, strings.ToUpper(value))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query history of %s: %w", value, err)
+	}
+	defer rows.Close()
+	byYear := make(map[int64][]*models.Name)
+	for rows.Next() {
+		var year int64
+		name, err := scanName(yearScanner{rows, &year})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read history of %s: %w", value, err)
+		}
+		byYear[year] = append(byYear[year], name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history of %s: %w", value, err)
+	}
+	if len(byYear) == 0 {
+		return nil, ErrNameNotFound
+	}
+
+	// generate response
+	var history []*models.NameHistoryEntry
+	for _, year := range sortedYears {
+		if len(byYear[year]) == 0 {
+			history = append(history, &models.NameHistoryEntry{Year: year})
+			continue
+		}
+		for _, name := range byYear[year] {
+			history = append(history, &models.NameHistoryEntry{
+				Year: year,
+				Name: name,
+			})
+		}
+	}
+	return history, nil
+}
+
+// yearScanner reads the year preceding nameColumns
+type yearScanner struct {
+	scanner
+	year *int64
+}
+
+func (y yearScanner) Scan(dest ...any) error {
+	return y.scanner.Scan(append([]any{y.year}, dest...)...)
+}
+
+func (s *SQLiteNamesDB) GetNamesAfter(ctx context.Context, year int64, gender models.Gender, afterId int64, limit int64) ([]*models.Name, error) {
+	if err := s.checkYear(ctx, year); err != nil {
+		return nil, err
+	}
+	return s.queryNames(ctx, `SELECT `+nameColumns+` FROM names
+		WHERE year = ? AND (? = '' OR gender = ?) AND id > ?
+		ORDER BY id
+		LIMIT ?`, year, string(gender), string(gender), afterId, limit)
+}
+
+func (s *SQLiteNamesDB) GetNamesBefore(ctx context.Context, year int64, gender models.Gender, beforeId int64, limit int64) ([]*models.Name, error) {
+	if err := s.checkYear(ctx, year); err != nil {
+		return nil, err
+	}
+	names, err := s.queryNames(ctx, `SELECT `+nameColumns+` FROM names
+		WHERE year = ? AND (? = '' OR gender = ?) AND id < ?
+		ORDER BY id DESC
+		LIMIT ?`, year, string(gender), string(gender), beforeId, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(names)-1; i < j; i, j = i+1, j-1 {
+		names[i], names[j] = names[j], names[i]
+	}
+	return names, nil
+}
+
+func (s *SQLiteNamesDB) Random(ctx context.Context, year int64, gender models.Gender, count int64, weighted bool, seed *int64, exclude map[int64]struct{}) ([]*models.Name, error) {
+	if err := s.checkYear(ctx, year); err != nil {
+		return nil, err
+	}
+	candidates, err := s.genderNames(ctx, year, gender)
+	if err != nil {
+		return nil, err
+	}
+	// names are sampled the same way as by NamesDB, so that a seed gives the same names in both adapters
+	ids := make([]int64, 0, len(candidates))
+	byId := make(map[int64]*models.Name, len(candidates))
+	for _, name := range candidates {
+		ids = append(ids, name.Id)
+		byId[name.Id] = name
+	}
+
+	// generate response
+	var names []*models.Name
+	weight := func(id int64) int64 { return byId[id].Count }
+	for _, id := range sampling.Sample(sampling.NewRand(seed), ids, weight, count, weighted, exclude) {
+		names = append(names, byId[id])
+	}
+	return names, nil
+}
+
+func (s *SQLiteNamesDB) GetSimilar(ctx context.Context, year int64, id int64, limit int64) ([]*models.SimilarName, error) {
+	name, err := s.GetName(ctx, year, id)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(ctx, `SELECT phonetic, folded, `+nameColumns+` FROM names WHERE year = ? AND gender = ? ORDER BY id`,
+		year, string(name.Gender))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query names similar to %d from %d: %w", id, year, err)
+	}
+	defer rows.Close()
+
+	type candidate struct {
+		name         *models.Name
+		code, folded string
+	}
+	var candidates []candidate
+	var code, folded string
+	for rows.Next() {
+		var c candidate
+		c.name, err = scanName(soundScanner{rows, &c.code, &c.folded})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read names similar to %d from %d: %w", id, year, err)
+		}
+		if c.name.Id == id {
+			code, folded = c.code, c.folded
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read names similar to %d from %d: %w", id, year, err)
+	}
+
+	result := []*models.SimilarName{}
+	for _, c := range candidates {
+		similar, ok := phonetic.Compare(code, folded, c.code, c.folded)
+		if !ok {
+			continue
+		}
+		similar.Name = c.name
+		result = append(result, similar)
+	}
+
+	phonetic.SortSimilar(result)
+	if int64(len(result)) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// soundScanner reads the phonetic code and the folded value preceding nameColumns
+type soundScanner struct {
+	scanner
+	code   *string
+	folded *string
+}
+
+func (s soundScanner) Scan(dest ...any) error {
+	return s.scanner.Scan(append([]any{s.code, s.folded}, dest...)...)
+}
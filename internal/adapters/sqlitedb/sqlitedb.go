@@ -0,0 +1,223 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/normalize"
+	"github.com/mwasilew2/go-service-template/internal/domain/phonetic"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+var ErrYearNotFound = ports.ErrYearNotFound
+var ErrNameNotFound = ports.ErrNameNotFound
+
+// importBatchSize is the number of names read at once from the source of an import
+const importBatchSize = 1000
+
+// SQLiteNamesDB serves names from an SQLite database filled by Import, so that datasets don't have to be parsed and
+// indexed on every start
+type SQLiteNamesDB struct {
+	db *sql.DB
+}
+
+// NewSQLiteNamesDB opens the database at path and applies migrations which haven't been applied to it yet, the
+// database is created if it doesn't exist
+func NewSQLiteNamesDB(path string) (*SQLiteNamesDB, error) {
+	dsn := "file:" + path + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database %s: %w", path, err)
+	}
+	if err := migrate(context.Background(), db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate database %s: %w", path, err)
+	}
+	return &SQLiteNamesDB{db: db}, nil
+}
+
+func (s *SQLiteNamesDB) Close() error {
+	return s.db.Close()
+}
+
+// migrate applies migrations in the order of their file names, every migration is applied in its own transaction
+func migrate(ctx context.Context, db *sql.DB) error {
+	if _, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    TEXT NOT NULL PRIMARY KEY,
+		applied_at TEXT NOT NULL
+	)`); err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
+	files, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		version := strings.TrimSuffix(strings.TrimPrefix(file, "migrations/"), ".sql")
+		var applied int
+		if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations WHERE version = ?`, version).Scan(&applied); err != nil {
+			return fmt.Errorf("failed to check migration %s: %w", version, err)
+		}
+		if applied > 0 {
+			continue
+		}
+		script, err := fs.ReadFile(migrations, file)
+		if err != nil {
+			return fmt.Errorf("failed to read migration %s: %w", version, err)
+		}
+
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to begin migration %s: %w", version, err)
+		}
+		if _, err := tx.ExecContext(ctx, string(script)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to apply migration %s: %w", version, err)
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)`, version, time.Now().UTC().Format(time.RFC3339)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record migration %s: %w", version, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit migration %s: %w", version, err)
+		}
+	}
+	return nil
+}
+
+// Import copies all names of given years from another names service, e.g. a NamesDB loaded from transformed
+// datasets. Names of an imported year which were stored before are replaced, other years are kept. Nothing is changed
+// if the import fails.
+func (s *SQLiteNamesDB) Import(ctx context.Context, source ports.NamesService, years []int64) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin import: %w", err)
+	}
+	defer tx.Rollback()
+	insert, err := tx.PrepareContext(ctx, `INSERT INTO names (
+		year, id, value, gender, count, rank, value_key, folded,
+		length, syllables, initial, ending, pattern, phonetic,
+		origin, etymology, meaning
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare import: %w", err)
+	}
+	defer insert.Close()
+
+	var imported int64
+	for _, year := range years {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM names WHERE year = ?`, year); err != nil {
+			return 0, fmt.Errorf("failed to remove names from %d: %w", year, err)
+		}
+		afterId := int64(-1)
+		for {
+			names, err := source.GetNamesAfter(ctx, year, "", afterId, importBatchSize)
+			if err != nil {
+				return 0, fmt.Errorf("failed to get names from %d: %w", year, err)
+			}
+			if len(names) == 0 {
+				break
+			}
+			for _, name := range names {
+				var origin, etymology, meaning sql.NullString
+				if name.Meta != nil {
+					origin = sql.NullString{String: name.Meta.Origin, Valid: true}
+					etymology = sql.NullString{String: name.Meta.Etymology, Valid: true}
+					meaning = sql.NullString{String: name.Meta.Meaning, Valid: true}
+				}
+				if _, err := insert.ExecContext(ctx,
+					year, name.Id, name.Value, string(name.Gender), name.Count, name.Rank,
+					strings.ToUpper(name.Value), normalize.Fold(name.Value),
+					name.Traits.Length, name.Traits.Syllables, name.Traits.Initial, name.Traits.Ending, name.Traits.Pattern,
+					phonetic.Encode(name.Value),
+					origin, etymology, meaning,
+				); err != nil {
+					return 0, fmt.Errorf("failed to import name %d from %d: %w", name.Id, year, err)
+				}
+				imported++
+			}
+			afterId = names[len(names)-1].Id
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit import: %w", err)
+	}
+	return imported, nil
+}
+
+// nameColumns are the columns read by scanName, in its order
+const nameColumns = `id, value, gender, count, rank, length, syllables, initial, ending, pattern, origin, etymology, meaning`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanName(row scanner) (*models.Name, error) {
+	var name models.Name
+	var gender string
+	var origin, etymology, meaning sql.NullString
+	if err := row.Scan(
+		&name.Id, &name.Value, &gender, &name.Count, &name.Rank,
+		&name.Traits.Length, &name.Traits.Syllables, &name.Traits.Initial, &name.Traits.Ending, &name.Traits.Pattern,
+		&origin, &etymology, &meaning,
+	); err != nil {
+		return nil, err
+	}
+	name.Gender = models.Gender(gender)
+	if origin.Valid {
+		name.Meta = &models.NameMeta{
+			Origin:    origin.String,
+			Etymology: etymology.String,
+			Meaning:   meaning.String,
+		}
+	}
+	return &name, nil
+}
+
+// queryNames returns names selected by a query which selects nameColumns
+func (s *SQLiteNamesDB) queryNames(ctx context.Context, query string, args ...any) ([]*models.Name, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query names: %w", err)
+	}
+	defer rows.Close()
+	var names []*models.Name
+	for rows.Next() {
+		name, err := scanName(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read name: %w", err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read names: %w", err)
+	}
+	return names, nil
+}
+
+// checkYear returns ErrYearNotFound if there are no names from a given year
+func (s *SQLiteNamesDB) checkYear(ctx context.Context, year int64) error {
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM names WHERE year = ?)`, year).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check year %d: %w", year, err)
+	}
+	if !exists {
+		return ErrYearNotFound
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package sqlitedb_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/mwasilew2/go-service-template/internal/adapters/namesdb"
+	"github.com/mwasilew2/go-service-template/internal/adapters/namestest"
+	"github.com/mwasilew2/go-service-template/internal/adapters/sqlitedb"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+func TestSQLiteNamesDB(t *testing.T) {
+	namestest.Run(t, func(t *testing.T, dataDir string) ports.NamesService {
+		namesDB, err := namesdb.NewNamesDB(dataDir)
+		if err != nil {
+			t.Fatalf("failed to load fixture: %v", err)
+		}
+		store, err := sqlitedb.NewSQLiteNamesDB(filepath.Join(t.TempDir(), "names.db"))
+		if err != nil {
+			t.Fatalf("failed to create database: %v", err)
+		}
+		t.Cleanup(func() { store.Close() })
+		years := []int64{namestest.FirstYear, namestest.SecondYear}
+		if _, err := store.Import(context.Background(), namesDB, years); err != nil {
+			t.Fatalf("failed to import fixture: %v", err)
+		}
+		return store
+	})
+}
@@ -0,0 +1,176 @@
+package tournamentsdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mwasilew2/go-service-template/internal/adapters/localstate"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+var ErrTournamentNotFound = ports.ErrTournamentNotFound
+
+// tournamentsSubdir is the directory in the state directory where tournaments are stored, a file per tournament
+var tournamentsSubdir = "tournaments"
+
+type tournamentRecord struct {
+	ShortlistId string            `json:"shortlistId"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	Contenders  []contenderRecord `json:"contenders"`
+	Games       []gameRecord      `json:"games"`
+}
+
+type contenderRecord struct {
+	Value  string        `json:"value"`
+	Gender models.Gender `json:"gender"`
+}
+
+type gameRecord struct {
+	Participant string    `json:"participant"`
+	Winner      int       `json:"winner"`
+	Loser       int       `json:"loser"`
+	PlayedAt    time.Time `json:"playedAt"`
+}
+
+// TournamentsDB keeps tournaments in memory and writes a tournament to its own JSON file in a state directory after
+// every change
+type TournamentsDB struct {
+	dir string
+
+	mu          sync.Mutex
+	tournaments map[string]*tournamentRecord
+}
+
+// NewTournamentsDB loads tournaments stored in stateDir, the directory is created if it doesn't exist
+func NewTournamentsDB(stateDir string) (*TournamentsDB, error) {
+	dir := filepath.Join(stateDir, tournamentsSubdir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create tournaments directory %s: %w", dir, err)
+	}
+	tournamentsDB := &TournamentsDB{
+		dir:         dir,
+		tournaments: make(map[string]*tournamentRecord),
+	}
+
+	dirFS := os.DirFS(dir)
+	files, err := fs.Glob(dirFS, "*.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tournaments in %s: %w", dir, err)
+	}
+	for _, file := range files {
+		data, err := fs.ReadFile(dirFS, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tournament %s: %w", file, err)
+		}
+		var record tournamentRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("failed to parse tournament %s: %w", file, err)
+		}
+		tournamentsDB.tournaments[strings.TrimSuffix(file, ".json")] = &record
+	}
+
+	return tournamentsDB, nil
+}
+
+func (t *TournamentsDB) CreateTournament(ctx context.Context, shortlistId string, contenders []*models.Contender) (*models.Tournament, error) {
+	id, err := localstate.NewId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate tournament id: %w", err)
+	}
+	record := &tournamentRecord{
+		ShortlistId: shortlistId,
+		CreatedAt:   time.Now().UTC(),
+		Games:       []gameRecord{},
+	}
+	for _, contender := range contenders {
+		record.Contenders = append(record.Contenders, contenderRecord{
+			Value:  contender.Value,
+			Gender: contender.Gender,
+		})
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err := t.save(id, record); err != nil {
+		return nil, err
+	}
+	t.tournaments[id] = record
+
+	return toTournament(id, record), nil
+}
+
+func (t *TournamentsDB) GetTournament(ctx context.Context, id string) (*models.Tournament, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	record, ok := t.tournaments[id]
+	if !ok {
+		return nil, ErrTournamentNotFound
+	}
+
+	return toTournament(id, record), nil
+}
+
+func (t *TournamentsDB) RecordGame(ctx context.Context, id string, game *models.Game) (*models.Tournament, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	record, ok := t.tournaments[id]
+	if !ok {
+		return nil, ErrTournamentNotFound
+	}
+
+	// records are never modified in place, so that a failed save leaves the tournament as it was
+	updated := *record
+	updated.Games = append(append([]gameRecord(nil), record.Games...), gameRecord{
+		Participant: game.Participant,
+		Winner:      game.Winner,
+		Loser:       game.Loser,
+		PlayedAt:    game.PlayedAt,
+	})
+	if err := t.save(id, &updated); err != nil {
+		return nil, err
+	}
+	t.tournaments[id] = &updated
+
+	return toTournament(id, &updated), nil
+}
+
+func (t *TournamentsDB) save(id string, record *tournamentRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tournament %s: %w", id, err)
+	}
+	return localstate.WriteFile(filepath.Join(t.dir, id+".json"), data)
+}
+
+func toTournament(id string, record *tournamentRecord) *models.Tournament {
+	tournament := &models.Tournament{
+		Id:          id,
+		ShortlistId: record.ShortlistId,
+		CreatedAt:   record.CreatedAt,
+		Contenders:  make([]*models.Contender, 0, len(record.Contenders)),
+		Games:       make([]*models.Game, 0, len(record.Games)),
+	}
+	for _, contender := range record.Contenders {
+		tournament.Contenders = append(tournament.Contenders, &models.Contender{
+			Value:  contender.Value,
+			Gender: contender.Gender,
+		})
+	}
+	for _, game := range record.Games {
+		tournament.Games = append(tournament.Games, &models.Game{
+			Participant: game.Participant,
+			Winner:      game.Winner,
+			Loser:       game.Loser,
+			PlayedAt:    game.PlayedAt,
+		})
+	}
+	return tournament
+}
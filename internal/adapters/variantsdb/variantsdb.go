@@ -0,0 +1,209 @@
+package variantsdb
+
+import (
+	"context"
+	"embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+//go:embed variants.csv
+var variantsEmbedded embed.FS
+var fileWithVariants = "variants.csv"
+
+// kindOrder is the order of kinds of variants in results, a name which is both a canonical form and a diminutive,
+// e.g. BARTOSZ, is reported as the former
+var kindOrder = map[models.VariantKind]int{
+	models.VariantCanonical:  0,
+	models.VariantDiminutive: 1,
+	models.VariantForeign:    2,
+}
+
+type node struct {
+	value string
+	// canonical forms this name is a variant of
+	canonicals []*node
+	// variants of this name, if it's a canonical form
+	variants []edge
+}
+
+type edge struct {
+	to       *node
+	kind     models.VariantKind
+	language string
+}
+
+// VariantsDB is a graph of canonical names linked to their diminutives and foreign equivalents. Variants are linked
+// to entries of NamesDB by value when they're requested, so that reloaded datasets are taken into account.
+type VariantsDB struct {
+	namesService ports.NamesService
+	// nodes by upper-cased value
+	nodes map[string]*node
+}
+
+// NewVariantsDB loads variants from a CSV file with name, variant, kind (diminutive or foreign) and language columns.
+// If path is empty, the embedded dataset is loaded instead.
+func NewVariantsDB(path string, namesService ports.NamesService) (*VariantsDB, error) {
+	variantsDB := &VariantsDB{
+		namesService: namesService,
+		nodes:        make(map[string]*node),
+	}
+
+	if path == "" {
+		if err := variantsDB.loadFile(variantsEmbedded, fileWithVariants); err != nil {
+			return nil, fmt.Errorf("failed to load embedded variants: %w", err)
+		}
+	} else {
+		if err := variantsDB.loadFile(os.DirFS(filepath.Dir(path)), filepath.Base(path)); err != nil {
+			return nil, fmt.Errorf("failed to load variants from %s: %w", path, err)
+		}
+	}
+
+	return variantsDB, nil
+}
+
+func (v *VariantsDB) loadFile(fsys fs.FS, filename string) error {
+	fd, err := fsys.Open(filename)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filename, err)
+	}
+	defer fd.Close()
+	r := csv.NewReader(fd)
+	r.FieldsPerRecord = 4 // name,variant,kind,language
+
+	header := true
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", filename, err)
+		}
+		if header {
+			header = false
+			if record[0] == "name" {
+				continue
+			}
+		}
+		canonical, variant := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if canonical == "" || variant == "" {
+			return fmt.Errorf("empty name in %s: %v", filename, record)
+		}
+		kind := models.VariantKind(record[2])
+		if kind != models.VariantDiminutive && kind != models.VariantForeign {
+			return fmt.Errorf("unknown kind of variant %s in %s", record[2], filename)
+		}
+		// foreign forms spelled like the canonical form, e.g. Adam, don't add anything to the graph
+		if strings.EqualFold(canonical, variant) {
+			continue
+		}
+
+		from, to := v.node(canonical), v.node(variant)
+		from.variants = append(from.variants, edge{to: to, kind: kind, language: strings.TrimSpace(record[3])})
+		linked := false
+		for _, existing := range to.canonicals {
+			linked = linked || existing == from
+		}
+		if !linked {
+			to.canonicals = append(to.canonicals, from)
+		}
+	}
+
+	return nil
+}
+
+func (v *VariantsDB) node(value string) *node {
+	key := strings.ToUpper(value)
+	n, ok := v.nodes[key]
+	if !ok {
+		n = &node{value: value}
+		v.nodes[key] = n
+	}
+	return n
+}
+
+func (v *VariantsDB) GetVariants(ctx context.Context, year int64, value string) ([]*models.Variant, error) {
+	key := strings.ToUpper(strings.TrimSpace(value))
+	n, ok := v.nodes[key]
+	if !ok {
+		return []*models.Variant{}, nil
+	}
+
+	// siblings are linked through canonical forms, e.g. OLEK and ALEX through ALEKSANDER
+	roots := n.canonicals
+	if len(n.variants) > 0 {
+		roots = append([]*node{n}, roots...)
+	}
+	result := []*models.Variant{}
+	byValue := make(map[string]*models.Variant)
+	add := func(value string, kind models.VariantKind, language string) {
+		key := strings.ToUpper(value)
+		if key == strings.ToUpper(n.value) {
+			return
+		}
+		variant, ok := byValue[key]
+		if !ok {
+			variant = &models.Variant{Value: value, Kind: kind, Languages: []string{}}
+			byValue[key] = variant
+			result = append(result, variant)
+		}
+		if kindOrder[kind] < kindOrder[variant.Kind] {
+			variant.Kind = kind
+		}
+		if language == "" {
+			return
+		}
+		for _, existing := range variant.Languages {
+			if existing == language {
+				return
+			}
+		}
+		variant.Languages = append(variant.Languages, language)
+	}
+	for _, root := range roots {
+		add(root.value, models.VariantCanonical, "")
+		for _, e := range root.variants {
+			add(e.to.value, e.kind, e.language)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return kindOrder[result[i].Kind] < kindOrder[result[j].Kind] })
+
+	for _, variant := range result {
+		entries, err := v.entries(ctx, year, variant.Value)
+		if err != nil {
+			return nil, err
+		}
+		variant.Entries = entries
+	}
+	return result, nil
+}
+
+// entries returns names with a given value in a given year, the most popular first
+func (v *VariantsDB) entries(ctx context.Context, year int64, value string) ([]*models.Name, error) {
+	history, err := v.namesService.GetNameHistory(ctx, value)
+	if errors.Is(err, ports.ErrNameNotFound) {
+		return []*models.Name{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of name %s: %w", value, err)
+	}
+	entries := []*models.Name{}
+	for _, entry := range history {
+		if entry.Year == year && entry.Name != nil {
+			entries = append(entries, entry.Name)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Count > entries[j].Count })
+	return entries, nil
+}
@@ -0,0 +1,148 @@
+// Package analysis computes practical traits of names, e.g. how long they are or how they end, and filters and
+// sorts names by them.
+package analysis
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/normalize"
+)
+
+// Polish vowels, y is always a vowel in Polish
+const vowels = "aąeęioóuy"
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune(vowels, unicode.ToLower(r))
+}
+
+// Analyze computes traits of a name
+func Analyze(value string) models.NameTraits {
+	letters := []rune{}
+	var pattern strings.Builder
+	for _, r := range value {
+		switch {
+		case !unicode.IsLetter(r):
+			// spaces and hyphens of compound names are kept in the pattern
+			pattern.WriteRune(r)
+			continue
+		case isVowel(r):
+			pattern.WriteRune('V')
+		default:
+			pattern.WriteRune('C')
+		}
+		letters = append(letters, unicode.ToUpper(r))
+	}
+
+	traits := models.NameTraits{
+		Length:    int64(len(letters)),
+		Syllables: syllables(value),
+		Pattern:   pattern.String(),
+	}
+	if len(letters) > 0 {
+		traits.Initial = string(letters[0])
+		traits.Ending = string(letters[len(letters)-1])
+	}
+	return traits
+}
+
+// syllables counts syllables following Polish spelling: every vowel is a syllable, except for i followed by another
+// vowel, which only softens the preceding consonant (e.g. MA-RIA, KA-ZI-MIERZ), y followed by another vowel, which
+// is pronounced like j in names of foreign origin (e.g. YA-RO-SLAW), and the diphthongs au (e.g. KLAU-DIA) and eu
+// at the start of a word (e.g. EU-GE-NIUSZ). Elsewhere e and u belong to different syllables, e.g. MA-TE-USZ.
+func syllables(value string) int64 {
+	runes := []rune(strings.ToLower(value))
+	var count int64
+	for i := 0; i < len(runes); i++ {
+		if !isVowel(runes[i]) {
+			continue
+		}
+		var next rune
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+		if (runes[i] == 'i' || runes[i] == 'y') && next != 0 && isVowel(next) {
+			continue
+		}
+		startsWord := i == 0 || !unicode.IsLetter(runes[i-1])
+		if next == 'u' && (runes[i] == 'a' || (runes[i] == 'e' && startsWord)) {
+			i++
+		}
+		count++
+	}
+	return count
+}
+
+// Matcher tells if names match the filters of a query
+type Matcher struct {
+	query   models.NameQuery
+	initial string
+	ending  string
+}
+
+func NewMatcher(query models.NameQuery) *Matcher {
+	return &Matcher{
+		query:   query,
+		initial: normalize.Fold(query.Initial),
+		ending:  normalize.Fold(query.Ending),
+	}
+}
+
+// Matches tells if a name matches the filters, initials and endings are compared ignoring case and diacritics
+func (m *Matcher) Matches(name *models.Name) bool {
+	f := m.query
+	t := name.Traits
+	switch {
+	case f.Gender != "" && name.Gender != f.Gender:
+		return false
+	case f.MinLength > 0 && t.Length < f.MinLength:
+		return false
+	case f.MaxLength > 0 && t.Length > f.MaxLength:
+		return false
+	case f.MinSyllables > 0 && t.Syllables < f.MinSyllables:
+		return false
+	case f.MaxSyllables > 0 && t.Syllables > f.MaxSyllables:
+		return false
+	case f.Origin != "" && (name.Meta == nil || !strings.EqualFold(name.Meta.Origin, f.Origin)):
+		return false
+	}
+	if m.initial == "" && m.ending == "" {
+		return true
+	}
+	folded := normalize.Fold(name.Value)
+	return strings.HasPrefix(folded, m.initial) && strings.HasSuffix(folded, m.ending)
+}
+
+// Less orders names as requested by a query, ties are broken by id
+func Less(a *models.Name, b *models.Name, query models.NameQuery) bool {
+	var cmp int
+	switch query.SortBy {
+	case models.SortByName:
+		cmp = strings.Compare(a.Value, b.Value)
+	case models.SortByLength:
+		cmp = compareInt(a.Traits.Length, b.Traits.Length)
+	case models.SortBySyllables:
+		cmp = compareInt(a.Traits.Syllables, b.Traits.Syllables)
+	case models.SortByCount:
+		cmp = compareInt(a.Count, b.Count)
+	}
+	if cmp == 0 {
+		cmp = compareInt(a.Id, b.Id)
+	}
+	if query.Descending {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+func compareInt(a int64, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
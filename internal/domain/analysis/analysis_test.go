@@ -0,0 +1,88 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/analysis"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		value string
+		want  models.NameTraits
+	}{
+		// e and u in the middle of a name are separate syllables
+		{"MATEUSZ", models.NameTraits{Length: 7, Syllables: 3, Initial: "M", Ending: "Z", Pattern: "CVCVVCC"}},
+		{"TADEUSZ", models.NameTraits{Length: 7, Syllables: 3, Initial: "T", Ending: "Z", Pattern: "CVCVVCC"}},
+		// eu at the start and au are diphthongs
+		{"EUGENIUSZ", models.NameTraits{Length: 9, Syllables: 3, Initial: "E", Ending: "Z", Pattern: "VVCVCVVCC"}},
+		{"KLAUDIA", models.NameTraits{Length: 7, Syllables: 2, Initial: "K", Ending: "A", Pattern: "CCVVCVV"}},
+		{"AUGUST", models.NameTraits{Length: 6, Syllables: 2, Initial: "A", Ending: "T", Pattern: "VVCVCC"}},
+		// i followed by a vowel only softens the preceding consonant
+		{"MARIA", models.NameTraits{Length: 5, Syllables: 2, Initial: "M", Ending: "A", Pattern: "CVCVV"}},
+		{"JULIA", models.NameTraits{Length: 5, Syllables: 2, Initial: "J", Ending: "A", Pattern: "CVCVV"}},
+		{"KAZIMIERZ", models.NameTraits{Length: 9, Syllables: 3, Initial: "K", Ending: "Z", Pattern: "CVCVCVVCC"}},
+		// y followed by a vowel sounds like j
+		{"YAROSLAW", models.NameTraits{Length: 8, Syllables: 3, Initial: "Y", Ending: "W", Pattern: "VVCVCCVC"}},
+		{"ZUZANNA", models.NameTraits{Length: 7, Syllables: 3, Initial: "Z", Ending: "A", Pattern: "CVCVCCV"}},
+		{"Łukasz", models.NameTraits{Length: 6, Syllables: 2, Initial: "Ł", Ending: "Z", Pattern: "CVCVCC"}},
+		// separators of compound names are kept in the pattern, but aren't letters
+		{"Anna-Maria", models.NameTraits{Length: 9, Syllables: 4, Initial: "A", Ending: "A", Pattern: "VCCV-CVCVV"}},
+		{"Ewa Eustachia", models.NameTraits{Length: 12, Syllables: 5, Initial: "E", Ending: "A", Pattern: "VCV VVCCVCCVV"}},
+		{"", models.NameTraits{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := analysis.Analyze(tt.value); got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	mateusz := &models.Name{Value: "MATEUSZ", Gender: models.GenderMale, Traits: analysis.Analyze("MATEUSZ")}
+	tests := []struct {
+		name  string
+		query models.NameQuery
+		want  bool
+	}{
+		{"no filters", models.NameQuery{}, true},
+		{"gender", models.NameQuery{Gender: models.GenderFemale}, false},
+		{"at least three syllables", models.NameQuery{MinSyllables: 3}, true},
+		{"at most two syllables", models.NameQuery{MaxSyllables: 2}, false},
+		{"length", models.NameQuery{MinLength: 7, MaxLength: 7}, true},
+		{"initial and ending ignore case", models.NameQuery{Initial: "ma", Ending: "usz"}, true},
+		{"other ending", models.NameQuery{Ending: "a"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := analysis.NewMatcher(tt.query).Matches(mateusz); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLess(t *testing.T) {
+	jan := &models.Name{Id: 1, Value: "JAN", Count: 10, Traits: analysis.Analyze("JAN")}
+	mateusz := &models.Name{Id: 0, Value: "MATEUSZ", Count: 10, Traits: analysis.Analyze("MATEUSZ")}
+	tests := []struct {
+		name  string
+		query models.NameQuery
+		want  bool
+	}{
+		{"by syllables", models.NameQuery{SortBy: models.SortBySyllables}, true},
+		{"by syllables, descending", models.NameQuery{SortBy: models.SortBySyllables, Descending: true}, false},
+		{"by name", models.NameQuery{SortBy: models.SortByName}, true},
+		{"ties broken by id", models.NameQuery{SortBy: models.SortByCount}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := analysis.Less(jan, mateusz, tt.query); got != tt.want {
+				t.Errorf("expected JAN before MATEUSZ to be %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
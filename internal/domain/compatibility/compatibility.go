@@ -0,0 +1,173 @@
+// Package compatibility checks how first names sound together with a surname.
+package compatibility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/analysis"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/normalize"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+var ErrEmptySurname = errors.New("surname is required")
+var ErrNoNames = errors.New("at least one name is required")
+
+// awkwardInitials are initials which read as an embarrassing word or abbreviation in Polish
+var awkwardInitials = map[string]struct{}{
+	"WC":  {},
+	"SS":  {},
+	"SB":  {},
+	"UB":  {},
+	"ZK":  {},
+	"DUP": {},
+}
+
+// digraphs are pairs of letters which stand for a single sound in Polish, folded like names are before comparisons
+var digraphs = []string{"dz", "sz", "cz", "rz", "ch"}
+
+// longNameSyllables is the number of syllables above which a full name is considered hard to say
+const longNameSyllables = 8
+
+type Service struct {
+	namesService ports.NamesService
+}
+
+func NewService(namesService ports.NamesService) *Service {
+	return &Service{
+		namesService: namesService,
+	}
+}
+
+// Check describes how each of the names sounds together with a surname
+func (s *Service) Check(ctx context.Context, surname string, names []string) ([]*models.Compatibility, error) {
+	surname = strings.TrimSpace(surname)
+	if surname == "" {
+		return nil, ErrEmptySurname
+	}
+	if len(names) == 0 {
+		return nil, ErrNoNames
+	}
+	surnameTraits := analysis.Analyze(surname)
+
+	var result []*models.Compatibility
+	for _, value := range names {
+		name, err := s.findName(ctx, value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find name %s: %w", value, err)
+		}
+		compatibility := &models.Compatibility{
+			Value:   strings.TrimSpace(value),
+			Surname: surname,
+			Name:    name,
+			Traits:  analysis.Analyze(value),
+		}
+		if name != nil {
+			compatibility.Value = name.Value
+			compatibility.Traits = name.Traits
+		}
+		check(compatibility, surnameTraits)
+		result = append(result, compatibility)
+	}
+
+	return result, nil
+}
+
+// findName returns the most recent entry of a name in the datasets, or nil if the name isn't in any of them
+func (s *Service) findName(ctx context.Context, value string) (*models.Name, error) {
+	history, err := s.namesService.GetNameHistory(ctx, strings.TrimSpace(value))
+	if errors.Is(err, ports.ErrNameNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var name *models.Name
+	for _, entry := range history {
+		if entry.Name != nil {
+			name = entry.Name
+		}
+	}
+	return name, nil
+}
+
+func check(c *models.Compatibility, surnameTraits models.NameTraits) {
+	// initials, every part of a double-barrelled surname has its own initial
+	initials := append(initialsOf(c.Value), initialsOf(c.Surname)...)
+	var dotted []string
+	for _, initial := range initials {
+		dotted = append(dotted, string(initial)+".")
+	}
+	c.Initials = strings.Join(dotted, "")
+	if _, ok := awkwardInitials[string(initials)]; ok {
+		c.AwkwardInitials = true
+		c.Warnings = append(c.Warnings, fmt.Sprintf("initials %s may be read as an embarrassing abbreviation", c.Initials))
+	}
+
+	// boundary between the name and the surname
+	name, surname := normalize.Fold(c.Value), normalize.Fold(c.Surname)
+	if lastSound(name) != "" && lastSound(name) == firstSound(surname) {
+		c.BoundaryRepeat = true
+		c.Warnings = append(c.Warnings, fmt.Sprintf("the name ends with the sound the surname starts with (%s), they may blend together", lastSound(name)))
+	}
+	if endsWithVowel(c.Traits.Pattern) && strings.HasPrefix(surnameTraits.Pattern, "V") {
+		c.VowelClash = true
+		c.Warnings = append(c.Warnings, "the name ends and the surname starts with a vowel, which may be hard to say")
+	}
+
+	// rhythm
+	c.Syllables = c.Traits.Syllables + surnameTraits.Syllables
+	c.Rhythm = fmt.Sprintf("%d-%d", c.Traits.Syllables, surnameTraits.Syllables)
+	if c.Traits.Syllables == surnameTraits.Syllables && c.Traits.Syllables > 1 {
+		c.Warnings = append(c.Warnings, "the name and the surname have the same number of syllables, which may sound monotonous")
+	}
+	if c.Syllables > longNameSyllables {
+		c.Warnings = append(c.Warnings, fmt.Sprintf("the full name has %d syllables, which may be long to say", c.Syllables))
+	}
+}
+
+// initialsOf returns upper-cased first letters of every part of a compound name, e.g. NK for Nowak-Kowalska
+func initialsOf(value string) []rune {
+	var initials []rune
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool { return r == ' ' || r == '-' }) {
+		for _, r := range part {
+			initials = append(initials, unicode.ToUpper(r))
+			break
+		}
+	}
+	return initials
+}
+
+func lastSound(folded string) string {
+	for _, digraph := range digraphs {
+		if strings.HasSuffix(folded, digraph) {
+			return digraph
+		}
+	}
+	runes := []rune(folded)
+	if len(runes) == 0 {
+		return ""
+	}
+	return string(runes[len(runes)-1])
+}
+
+func firstSound(folded string) string {
+	for _, digraph := range digraphs {
+		if strings.HasPrefix(folded, digraph) {
+			return digraph
+		}
+	}
+	runes := []rune(folded)
+	if len(runes) == 0 {
+		return ""
+	}
+	return string(runes[0])
+}
+
+func endsWithVowel(pattern string) bool {
+	return strings.HasSuffix(pattern, "V")
+}
@@ -0,0 +1,64 @@
+package compatibility
+
+import (
+	"testing"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/analysis"
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		value         string
+		surname       string
+		wantInitials  string
+		wantRhythm    string
+		wantSyllables int64
+		wantAwkward   bool
+		wantBoundary  bool
+		wantClash     bool
+		wantWarnings  int
+	}{
+		// MA-TE-USZ has three syllables, so the rhythm isn't monotonous
+		{"Mateusz", "Nowak", "M.N.", "3-2", 5, false, false, false, 0},
+		{"Marek", "Nowak", "M.N.", "2-2", 4, false, false, false, 1},
+		// sz is a single sound
+		{"Łukasz", "Szymański", "Ł.S.", "2-3", 5, false, true, false, 1},
+		{"Jakub", "Bednarek", "J.B.", "2-3", 5, false, true, false, 1},
+		{"Ewa", "Orłowska", "E.O.", "2-3", 5, false, false, true, 1},
+		{"Wiktor", "Czarnecki", "W.C.", "2-3", 5, true, false, false, 1},
+		// every part of a double-barrelled surname has its own initial and syllables
+		{"Anna", "Nowak-Kowalska", "A.N.K.", "2-5", 7, false, false, false, 0},
+		{"Dorota", "Urban-Pawlak", "D.U.P.", "3-4", 7, true, false, true, 2},
+		{"Maksymilian", "Konstantynowicz-Wiśniewska", "M.K.W.", "4-8", 12, false, false, false, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value+" "+tt.surname, func(t *testing.T) {
+			c := &models.Compatibility{
+				Value:   tt.value,
+				Surname: tt.surname,
+				Traits:  analysis.Analyze(tt.value),
+			}
+			check(c, analysis.Analyze(tt.surname))
+
+			if c.Initials != tt.wantInitials {
+				t.Errorf("expected initials %s, got %s", tt.wantInitials, c.Initials)
+			}
+			if c.Rhythm != tt.wantRhythm || c.Syllables != tt.wantSyllables {
+				t.Errorf("expected rhythm %s of %d syllables, got %s of %d", tt.wantRhythm, tt.wantSyllables, c.Rhythm, c.Syllables)
+			}
+			if c.AwkwardInitials != tt.wantAwkward {
+				t.Errorf("expected awkward initials %v, got %v", tt.wantAwkward, c.AwkwardInitials)
+			}
+			if c.BoundaryRepeat != tt.wantBoundary {
+				t.Errorf("expected boundary repeat %v, got %v", tt.wantBoundary, c.BoundaryRepeat)
+			}
+			if c.VowelClash != tt.wantClash {
+				t.Errorf("expected vowel clash %v, got %v", tt.wantClash, c.VowelClash)
+			}
+			if len(c.Warnings) != tt.wantWarnings {
+				t.Errorf("expected %d warnings, got %q", tt.wantWarnings, c.Warnings)
+			}
+		})
+	}
+}
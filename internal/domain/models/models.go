@@ -1,6 +1,290 @@
 package models
 
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var ErrUnknownGender = errors.New("unknown gender")
+var ErrInvalidNameDay = errors.New("name day has to be a valid date formatted as MM-DD")
+
+type Gender string
+
+const (
+	GenderMale   Gender = "male"
+	GenderFemale Gender = "female"
+)
+
+// ParseGender accepts both the normalized values used in the transformed datasets
+// and the labels used in the source statistical data.
+func ParseGender(s string) (Gender, error) {
+	switch s {
+	case string(GenderMale), "MĘŻCZYZNA":
+		return GenderMale, nil
+	case string(GenderFemale), "KOBIETA":
+		return GenderFemale, nil
+	default:
+		return "", ErrUnknownGender
+	}
+}
+
 type Name struct {
-	Id    int64
+	Id     int64
+	Value  string
+	Gender Gender
+	Count  int64
+	// position of the name among names of the same gender in a given year, by the number of occurrences
+	Rank   int64
+	Traits NameTraits
+	// nil if nothing is known about the meaning and origin of the name
+	Meta *NameMeta
+}
+
+// NameMeta describes the meaning and origin of a name
+type NameMeta struct {
+	// the language or language family the name comes from, e.g. Slavic, Hebrew, Latin
+	Origin    string
+	Etymology string
+	Meaning   string
+}
+
+// NameTraits are practical traits of a name, computed by the analysis package
+type NameTraits struct {
+	// number of letters
+	Length    int64
+	Syllables int64
+	// first and last letters, upper-cased
+	Initial string
+	Ending  string
+	// C for every consonant and V for every vowel, other characters are kept, e.g. CVCVC for JAKUB
+	Pattern string
+}
+
+// NameSortKey is what names are sorted by
+type NameSortKey string
+
+const (
+	SortById        NameSortKey = "id"
+	SortByName      NameSortKey = "name"
+	SortByLength    NameSortKey = "length"
+	SortBySyllables NameSortKey = "syllables"
+	SortByCount     NameSortKey = "count"
+)
+
+// NameQuery filters and sorts names, zero values don't filter anything
+type NameQuery struct {
+	Gender       Gender
+	MinLength    int64
+	MaxLength    int64
+	MinSyllables int64
+	MaxSyllables int64
+	// prefix and suffix of the name
+	Initial string
+	Ending  string
+	// origin of the name, compared ignoring case, names without metadata never match
+	Origin     string
+	SortBy     NameSortKey
+	Descending bool
+}
+
+type NameHistoryEntry struct {
+	Year int64
+	// nil if the name wasn't given in a given year
+	Name *Name
+}
+
+// Trend describes how popularity of a name changed between two years
+type Trend struct {
+	Value  string
+	Gender Gender
+	// nil if the name wasn't given in the first year
+	From *Name
+	// nil if the name wasn't given in the second year
+	To *Name
+	// positive if the name moved up the ranking
+	RankChange int64
+	// change of the fraction of all occurrences of names of the same gender
+	ShareChange float64
+}
+
+type TrendsReport struct {
+	From        int64
+	To          int64
+	Risers      []*Trend
+	Fallers     []*Trend
+	NewEntrants []*Trend
+	Dropouts    []*Trend
+}
+
+// Shortlist is a list of favourite names
+type Shortlist struct {
+	Id        string
+	CreatedAt time.Time
+	// in the order the names were added
+	Names []*ShortlistEntry
+}
+
+type ShortlistEntry struct {
+	Value   string
+	Gender  Gender
+	AddedAt time.Time
+}
+
+// Session lets two participants vote on names independently, names both of them like are matches
+type Session struct {
+	Id        string
+	CreatedAt time.Time
+	// names are served from this year
+	Year int64
+	// empty if names of both genders are served
+	Gender       Gender
+	Participants []*Participant
+}
+
+type Participant struct {
+	// invite token, it identifies the participant
+	Token string
+	// in the order they were cast
+	Votes []*Vote
+}
+
+type Vote struct {
+	Value   string
+	Gender  Gender
+	Like    bool
+	VotedAt time.Time
+}
+
+// Match is a name liked by all participants of a session
+type Match struct {
+	Value  string
+	Gender Gender
+	// when the last participant liked the name
+	MatchedAt time.Time
+}
+
+// Tournament ranks names from a shortlist by comparing them in pairs
+type Tournament struct {
+	Id          string
+	ShortlistId string
+	CreatedAt   time.Time
+	// names taken from the shortlist when the tournament was created, identified by their position
+	Contenders []*Contender
+	// in the order they were played
+	Games []*Game
+}
+
+type Contender struct {
+	Value  string
+	Gender Gender
+}
+
+// Game is a comparison of two contenders by a participant, contenders are identified by their position
+type Game struct {
+	// label chosen by the participant, it isn't authenticated
+	Participant string
+	Winner      int
+	Loser       int
+	PlayedAt    time.Time
+}
+
+// Standing is a position of a contender in a leaderboard of a tournament
+type Standing struct {
+	// position of the contender in the tournament
+	Id        int
+	Contender *Contender
+	Rating    float64
+	Games     int64
+	Wins      int64
+}
+
+// Compatibility describes how a first name sounds together with a surname
+type Compatibility struct {
+	Value   string
+	Surname string
+	// nil if the name isn't in any of the datasets, traits are computed from the value then
+	Name   *Name
+	Traits NameTraits
+	// e.g. J.K. for Jakub Kowalski
+	Initials string
+	// the initials read as a word or an abbreviation which could be embarrassing
+	AwkwardInitials bool
+	// the name ends with the sound the surname starts with, e.g. Łukasz Szymański
+	BoundaryRepeat bool
+	// the name ends and the surname starts with a vowel, e.g. Ola Adamska
+	VowelClash bool
+	// number of syllables of the name and the surname together
+	Syllables int64
+	// syllables of the name and of the surname, e.g. 2-3
+	Rhythm   string
+	Warnings []string
+}
+
+// NameDay is a day of the year on which a name is celebrated, it's the same every year
+type NameDay struct {
+	Month time.Month
+	Day   int
+}
+
+// ParseNameDay accepts dates formatted as MM-DD, 02-29 is a valid name day
+func ParseNameDay(s string) (NameDay, error) {
+	if len(s) != len("MM-DD") || s[2] != '-' {
+		return NameDay{}, ErrInvalidNameDay
+	}
+	month, err := strconv.Atoi(s[:2])
+	if err != nil {
+		return NameDay{}, ErrInvalidNameDay
+	}
+	day, err := strconv.Atoi(s[3:])
+	if err != nil {
+		return NameDay{}, ErrInvalidNameDay
+	}
+	// a leap year, so that name days on the 29th of February are valid
+	date := time.Date(2000, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Month() != time.Month(month) || date.Day() != day {
+		return NameDay{}, ErrInvalidNameDay
+	}
+	return NameDay{Month: date.Month(), Day: date.Day()}, nil
+}
+
+func (d NameDay) String() string {
+	return fmt.Sprintf("%02d-%02d", d.Month, d.Day)
+}
+
+// Celebration is a name celebrated on a name day, together with its entries in a given year, one per gender the name
+// was given to, the most popular first. Entries are empty if the name wasn't given in that year.
+type Celebration struct {
+	Value   string
+	Entries []*Name
+}
+
+// VariantKind tells how a variant relates to the canonical form of a name
+type VariantKind string
+
+const (
+	VariantCanonical  VariantKind = "canonical"
+	VariantDiminutive VariantKind = "diminutive"
+	VariantForeign    VariantKind = "foreign"
+)
+
+// Variant is a name related to another one, e.g. OLEK and ALEX are variants of ALEKSANDER and of each other. Entries
+// are names with the value of the variant in a given year, one per gender, the most popular first, empty if the
+// variant wasn't given in that year.
+type Variant struct {
 	Value string
+	Kind  VariantKind
+	// languages of a foreign form, e.g. en and de for Alexander
+	Languages []string
+	Entries   []*Name
+}
+
+// SimilarName is a name which sounds like another one
+type SimilarName struct {
+	Name *Name
+	// number of edits between phonetic codes of the names, 0 if they sound alike
+	PhoneticDistance int64
+	// number of edits between the names, ignoring case and diacritics
+	EditDistance int64
 }
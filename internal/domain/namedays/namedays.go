@@ -0,0 +1,70 @@
+// Package namedays joins the calendar of name days with popularity of names.
+package namedays
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+type Service struct {
+	nameDays     ports.NameDays
+	namesService ports.NamesService
+}
+
+func NewService(nameDays ports.NameDays, namesService ports.NamesService) *Service {
+	return &Service{
+		nameDays:     nameDays,
+		namesService: namesService,
+	}
+}
+
+// ForName returns a name with a given id in a given year and days on which it's celebrated
+func (s *Service) ForName(ctx context.Context, year int64, id int64) (*models.Name, []models.NameDay, error) {
+	name, err := s.namesService.GetName(ctx, year, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get name: %w", err)
+	}
+	days, err := s.nameDays.GetNameDays(ctx, name.Value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get name days: %w", err)
+	}
+	return name, days, nil
+}
+
+// On returns names celebrated on a given day with their entries in a given year, the most popular names first. Names
+// which weren't given in that year come last, in the order of the calendar.
+func (s *Service) On(ctx context.Context, year int64, day models.NameDay) ([]*models.Celebration, error) {
+	values, err := s.nameDays.GetNames(ctx, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get names celebrated on %s: %w", day, err)
+	}
+
+	result := []*models.Celebration{}
+	counts := make(map[*models.Celebration]int64)
+	for _, value := range values {
+		celebration := &models.Celebration{Value: value, Entries: []*models.Name{}}
+		history, err := s.namesService.GetNameHistory(ctx, value)
+		if err != nil && !errors.Is(err, ports.ErrNameNotFound) {
+			return nil, fmt.Errorf("failed to get history of name %s: %w", value, err)
+		}
+		for _, entry := range history {
+			if entry.Year != year || entry.Name == nil {
+				continue
+			}
+			celebration.Entries = append(celebration.Entries, entry.Name)
+			counts[celebration] += entry.Name.Count
+		}
+		sort.SliceStable(celebration.Entries, func(i, j int) bool {
+			return celebration.Entries[i].Count > celebration.Entries[j].Count
+		})
+		result = append(result, celebration)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return counts[result[i]] > counts[result[j]] })
+
+	return result, nil
+}
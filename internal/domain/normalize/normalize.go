@@ -0,0 +1,30 @@
+// Package normalize folds names into a form suitable for comparisons which ignore case and diacritics.
+package normalize
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// letters which don't decompose into a base letter and a combining mark
+var undecomposable = strings.NewReplacer(
+	"ł", "l",
+	"đ", "d",
+	"ø", "o",
+	"ß", "ss",
+)
+
+// Fold lowercases a name and strips diacritics from it, e.g. both ŁUKASZ and Łukasz become lukasz.
+func Fold(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, strings.ToLower(strings.TrimSpace(s)))
+	if err != nil {
+		// the transformers used above never fail on valid input, fall back to case folding only
+		folded = strings.ToLower(strings.TrimSpace(s))
+	}
+	return undecomposable.Replace(folded)
+}
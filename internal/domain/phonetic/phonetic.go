@@ -0,0 +1,214 @@
+// Package phonetic encodes names by how they sound in Polish, so that names spelled differently but pronounced
+// alike, e.g. ALEKSANDER and ALEXANDER or ZOFIA and SOPHIA, get equal or close codes.
+package phonetic
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+const (
+	// maxPhoneticDistance is the largest number of edits between phonetic codes of names which sound alike
+	maxPhoneticDistance = 1
+	// maxEditDistance is the largest number of edits between names which are spelled alike
+	maxEditDistance = 2
+)
+
+// classes of sounds, consonants which sound alike share a class
+const (
+	vowel     = 'A'
+	labial    = 'P' // p, b
+	dental    = 'T' // t, d
+	velar     = 'K' // k, g
+	fricative = 'F' // f, w, v
+	hissing   = 'S' // s, z, c, dz
+	hushing   = 'X' // sz, ż, rz, cz, dż
+	soft      = 'C' // ś, ź, ć, dź and si, zi, ci, dzi
+	aspirate  = 'H' // h, ch
+	lateral   = 'L' // l, ł
+	trill     = 'R' // r
+	bilabial  = 'M' // m
+	nasal     = 'N' // n, ń, ni
+	glide     = 'J' // j, and i or y before a vowel at the start of a name
+)
+
+// spellings maps groups of letters to classes of sounds, longer groups come first. They cover Polish digraphs and
+// softened consonants as well as spellings common in foreign forms of names.
+var spellings = []struct {
+	letters string
+	classes []rune
+}{
+	{"dzi", []rune{soft}},
+	{"dź", []rune{soft}},
+	{"dż", []rune{hushing}},
+	{"dz", []rune{hissing}},
+	{"sz", []rune{hushing}},
+	{"cz", []rune{hushing}},
+	{"rz", []rune{hushing}},
+	{"si", []rune{soft}},
+	{"zi", []rune{soft}},
+	{"ci", []rune{soft}},
+	{"ni", []rune{nasal}},
+	{"ch", []rune{aspirate}},
+	{"ph", []rune{fricative}},
+	{"th", []rune{dental}},
+	{"ck", []rune{velar}},
+	{"qu", []rune{velar, fricative}},
+	{"x", []rune{velar, hissing}},
+	{"ą", []rune{vowel, nasal}},
+	{"ę", []rune{vowel, nasal}},
+}
+
+var letters = map[rune]rune{
+	'p': labial, 'b': labial,
+	't': dental, 'd': dental,
+	'k': velar, 'g': velar, 'q': velar,
+	'f': fricative, 'w': fricative, 'v': fricative,
+	's': hissing, 'z': hissing, 'c': hissing,
+	'ż': hushing,
+	'ś': soft, 'ź': soft, 'ć': soft,
+	'h': aspirate,
+	'l': lateral, 'ł': lateral,
+	'r': trill,
+	'm': bilabial,
+	'n': nasal, 'ń': nasal,
+	'j': glide,
+	'a': vowel, 'e': vowel, 'i': vowel, 'o': vowel, 'u': vowel, 'y': vowel, 'ó': vowel,
+}
+
+// Encode returns the phonetic code of a name: classes of its consonants, with repeated ones collapsed, preceded by A
+// if the name starts with a vowel. Other vowels are left out, as they differ the most between forms of a name.
+func Encode(value string) string {
+	var word []rune
+	for _, r := range strings.ToLower(value) {
+		if unicode.IsLetter(r) {
+			word = append(word, r)
+		}
+	}
+	classes := sounds(word)
+
+	var code strings.Builder
+	var last rune
+	for i, class := range classes {
+		switch {
+		case class == vowel:
+			if i == 0 {
+				code.WriteRune(vowel)
+			}
+		case class != last:
+			code.WriteRune(class)
+		}
+		last = class
+	}
+	return code.String()
+}
+
+// sounds splits a lower-cased word into classes of sounds
+func sounds(word []rune) []rune {
+	var classes []rune
+	for i := 0; i < len(word); {
+		matched := false
+		for _, spelling := range spellings {
+			group := []rune(spelling.letters)
+			if i+len(group) > len(word) || string(word[i:i+len(group)]) != spelling.letters {
+				continue
+			}
+			// softening i is pronounced as a vowel unless another vowel follows it, e.g. MA-RI-A but KA-ZI-MIERZ
+			classes = append(classes, spelling.classes...)
+			if group[len(group)-1] == 'i' && (i+len(group) == len(word) || letters[word[i+len(group)]] != vowel) {
+				classes = append(classes, vowel)
+			}
+			i += len(group)
+			matched = true
+			break
+		}
+		if matched {
+			continue
+		}
+		class, ok := letters[word[i]]
+		if !ok {
+			i++
+			continue
+		}
+		// i and y before a vowel at the start of a name sound like j, e.g. YAKUB
+		if i == 0 && (word[i] == 'i' || word[i] == 'y') && len(word) > 1 && letters[word[1]] == vowel {
+			class = glide
+		}
+		// h after a vowel and before a consonant is silent in foreign forms, e.g. JOHN, and barely heard in Polish
+		// ones, e.g. BOHDAN
+		if word[i] == 'h' && i > 0 && letters[word[i-1]] == vowel && (i+1 == len(word) || letters[word[i+1]] != vowel) {
+			i++
+			continue
+		}
+		classes = append(classes, class)
+		i++
+	}
+	return classes
+}
+
+// Distance returns the Levenshtein distance between two strings, i.e. the number of runes which have to be inserted,
+// removed or replaced to turn one into the other
+func Distance(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = minOf(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// Compare returns distances between phonetic codes and between folded values of two names, and whether the names
+// sound or are spelled alike
+func Compare(code string, folded string, otherCode string, otherFolded string) (*models.SimilarName, bool) {
+	phoneticDistance := Distance(code, otherCode)
+	editDistance := Distance(folded, otherFolded)
+	similar := &models.SimilarName{
+		PhoneticDistance: int64(phoneticDistance),
+		EditDistance:     int64(editDistance),
+	}
+	return similar, phoneticDistance <= maxPhoneticDistance || editDistance <= maxEditDistance
+}
+
+// SortSimilar orders similar names, names which sound alike come first, ties are broken by spelling, then by
+// popularity
+func SortSimilar(names []*models.SimilarName) {
+	sort.Slice(names, func(i, j int) bool {
+		a, b := names[i], names[j]
+		switch {
+		case a.PhoneticDistance != b.PhoneticDistance:
+			return a.PhoneticDistance < b.PhoneticDistance
+		case a.EditDistance != b.EditDistance:
+			return a.EditDistance < b.EditDistance
+		case a.Name.Rank != b.Name.Rank:
+			return a.Name.Rank < b.Name.Rank
+		default:
+			return a.Name.Id < b.Name.Id
+		}
+	})
+}
+
+// minOf returns the smallest of values
+func minOf(values ...int) int {
+	result := values[0]
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+	return result
+}
@@ -0,0 +1,114 @@
+package phonetic_test
+
+import (
+	"testing"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/phonetic"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		// Polish digraphs and ż are one hushing sound, different from s and z
+		{"SZYMON", "XMN"},
+		{"CZESŁAW", "XSLF"},
+		{"GRZEGORZ", "KXKX"},
+		{"ŻANETA", "XNT"},
+		{"DŻESIKA", "XCK"},
+		{"TOMASZ", "TMX"},
+		{"TOMAS", "TMS"},
+		{"ŁUKASZ", "LKX"},
+		{"LUKAS", "LKS"},
+		// softened consonants, the softening i is a vowel unless another vowel follows it
+		{"KAZIMIERZ", "KCMX"},
+		{"ŚWIĘTOSŁAW", "CFNTSLF"},
+		{"NIKODEM", "NKTM"},
+		// vowels other than the first one are left out
+		{"ANTONI", "ANTN"},
+		{"ANTON", "ANTN"},
+		{"ANTONIO", "ANTN"},
+		// i and y before a vowel at the start sound like j, h after a vowel is silent
+		{"JAN", "JN"},
+		{"IAN", "JN"},
+		{"JOHN", "JN"},
+		{"YAKUB", "JKP"},
+		{"JAKUB", "JKP"},
+		// foreign spellings
+		{"ALEXANDER", "ALKSNTR"},
+		{"ALEKSANDER", "ALKSNTR"},
+		{"SOPHIA", "SF"},
+		{"ZOFIA", "SF"},
+		// case and non-letters are ignored
+		{"Anna-Maria", "ANMR"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := phonetic.Encode(tt.value); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"anton", "anton", 0},
+		{"anton", "antoni", 1},
+		{"jan", "ian", 1},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		// runes are compared, not bytes
+		{"łukasz", "lukasz", 1},
+		{"LKX", "LKS", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := phonetic.Distance(tt.a, tt.b); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+			if got := phonetic.Distance(tt.b, tt.a); got != tt.want {
+				t.Errorf("expected %d the other way round, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		folded       string
+		other        string
+		otherFolded  string
+		wantPhonetic int64
+		wantEdit     int64
+		wantSimilar  bool
+	}{
+		{"equal codes", "ANTONI", "antoni", "ANTON", "anton", 0, 1, true},
+		{"equal codes, far spelling", "IAN", "ian", "JOHN", "john", 0, 3, true},
+		{"codes one edit apart", "ŁUKASZ", "lukasz", "LUKAS", "lukas", 1, 1, true},
+		{"codes two edits apart, spelling two edits apart", "ADAM", "adam", "ALAN", "alan", 2, 2, true},
+		{"codes two edits apart, spelling three edits apart", "ADAM", "adam", "OLAF", "olaf", 2, 3, false},
+		{"different names", "JAN", "jan", "KAZIMIERZ", "kazimierz", 4, 8, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			similar, ok := phonetic.Compare(phonetic.Encode(tt.value), tt.folded, phonetic.Encode(tt.other), tt.otherFolded)
+			if similar.PhoneticDistance != tt.wantPhonetic || similar.EditDistance != tt.wantEdit {
+				t.Errorf("expected distances %d and %d, got %d and %d", tt.wantPhonetic, tt.wantEdit, similar.PhoneticDistance, similar.EditDistance)
+			}
+			if ok != tt.wantSimilar {
+				t.Errorf("expected similar %v, got %v", tt.wantSimilar, ok)
+			}
+		})
+	}
+}
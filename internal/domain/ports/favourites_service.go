@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+var ErrShortlistNotFound = errors.New("shortlist not found")
+
+type FavouritesService interface {
+	// CreateShortlist creates an empty shortlist with an unguessable id
+	CreateShortlist(ctx context.Context) (*models.Shortlist, error)
+	GetShortlist(ctx context.Context, id string) (*models.Shortlist, error)
+	// AddName adds a name to a shortlist, adding a name which is already on the shortlist has no effect
+	AddName(ctx context.Context, id string, value string, gender models.Gender) (*models.Shortlist, error)
+	// RemoveName removes a name from a shortlist, ignoring case. The name is removed for both genders if gender is
+	// empty. Removing a name which isn't on the shortlist has no effect.
+	RemoveName(ctx context.Context, id string, value string, gender models.Gender) (*models.Shortlist, error)
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+type NameDays interface {
+	// GetNameDays returns days on which a name is celebrated, ordered by date, ignoring case. The result is empty if
+	// the name isn't in the calendar.
+	GetNameDays(ctx context.Context, value string) ([]models.NameDay, error)
+	// GetNames returns names celebrated on a given day, in the order of the calendar
+	GetNames(ctx context.Context, day models.NameDay) ([]string, error)
+}
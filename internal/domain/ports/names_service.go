@@ -2,13 +2,42 @@ package ports
 
 import (
 	"context"
+	"errors"
 
 	"github.com/mwasilew2/go-service-template/internal/domain/models"
 )
 
+var ErrYearNotFound = errors.New("year not found")
+var ErrNameNotFound = errors.New("name not found")
+
 type NamesService interface {
 	GetName(ctx context.Context, year int64, id int64) (*models.Name, error)
 	GetPage(ctx context.Context, year int64, page int64, limit int64) ([]*models.Name, error)
 	GetYearsAvailable(ctx context.Context) (map[int64]struct{}, error)
 	GetNoOfEntries(ctx context.Context, year int64) (int64, error)
+	GetPageByGender(ctx context.Context, year int64, gender models.Gender, page int64, limit int64) ([]*models.Name, error)
+	GetNoOfEntriesByGender(ctx context.Context, year int64, gender models.Gender) (int64, error)
+	// GetPageByQuery returns a page of names from a given year which match the filters of a query, sorted as requested
+	// by it, and the number of all matching names
+	GetPageByQuery(ctx context.Context, year int64, query models.NameQuery, page int64, limit int64) ([]*models.Name, int64, error)
+	// GetNamesAfter returns up to limit names with ids greater than afterId, ordered by id. Names of all genders are
+	// returned if gender is empty.
+	GetNamesAfter(ctx context.Context, year int64, gender models.Gender, afterId int64, limit int64) ([]*models.Name, error)
+	// GetNamesBefore returns up to limit names with ids closest to, but lower than beforeId, ordered by id. Names of
+	// all genders are returned if gender is empty.
+	GetNamesBefore(ctx context.Context, year int64, gender models.Gender, beforeId int64, limit int64) ([]*models.Name, error)
+	// Search returns names from a given year which start with or contain the query, ignoring case and diacritics
+	Search(ctx context.Context, year int64, query string, limit int64) ([]*models.Name, error)
+	// GetNameHistory returns a name in every available year, ordered by year. Years in which the name wasn't given
+	// have an entry without a name, years in which it was given to both genders have an entry per gender. The value is
+	// matched ignoring case, but not diacritics, as names differing only in diacritics are different names.
+	GetNameHistory(ctx context.Context, value string) ([]*models.NameHistoryEntry, error)
+	// Random returns up to count distinct names from a given year, of a given gender or of all genders if gender is
+	// empty, skipping names with excluded ids. Names are sampled uniformly, or proportionally to the number of
+	// occurrences if weighted is set. The same seed gives the same names for the same dataset, a random seed is used
+	// if seed is nil.
+	Random(ctx context.Context, year int64, gender models.Gender, count int64, weighted bool, seed *int64, exclude map[int64]struct{}) ([]*models.Name, error)
+	// GetSimilar returns up to limit names of the same gender as a name with a given id which sound like it or are
+	// spelled alike, the most similar first
+	GetSimilar(ctx context.Context, year int64, id int64, limit int64) ([]*models.SimilarName, error)
 }
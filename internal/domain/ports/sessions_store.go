@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// SessionsStore persists sessions, the rules of sessions are implemented by the sessions domain service
+type SessionsStore interface {
+	// CreateSession stores a new session with an unguessable id and an unguessable invite token per participant
+	CreateSession(ctx context.Context, year int64, gender models.Gender, participants int) (*models.Session, error)
+	GetSession(ctx context.Context, id string) (*models.Session, error)
+	// RecordVote stores a vote of a participant, identified by the position in the list of participants. A previous
+	// vote of the participant for the same name is replaced.
+	RecordVote(ctx context.Context, id string, participant int, vote *models.Vote) (*models.Session, error)
+}
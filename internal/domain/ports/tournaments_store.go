@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+var ErrTournamentNotFound = errors.New("tournament not found")
+
+// TournamentsStore persists tournaments and the games played in them, ratings are computed by the tournaments domain
+// service
+type TournamentsStore interface {
+	// CreateTournament stores a new tournament with an unguessable id
+	CreateTournament(ctx context.Context, shortlistId string, contenders []*models.Contender) (*models.Tournament, error)
+	GetTournament(ctx context.Context, id string) (*models.Tournament, error)
+	// RecordGame appends a game to a tournament
+	RecordGame(ctx context.Context, id string, game *models.Game) (*models.Tournament, error)
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+)
+
+type VariantsService interface {
+	// GetVariants returns names related to a name, ignoring case: its canonical forms, diminutives and foreign
+	// equivalents, together with their entries in a given year. Canonical forms come first, then diminutives, then
+	// foreign forms. The result is empty if the name has no known variants.
+	GetVariants(ctx context.Context, year int64, value string) ([]*models.Variant, error)
+}
@@ -0,0 +1,55 @@
+// Package sampling picks random names, either uniformly or proportionally to how many times they were given.
+package sampling
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// NewRand returns a source of randomness seeded with a given seed, or with a random one if seed is nil
+func NewRand(seed *int64) *rand.Rand {
+	if seed != nil {
+		return rand.New(rand.NewSource(*seed))
+	}
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// Sample picks up to count distinct ids in a random order, skipping excluded ids. Candidates are always visited in the
+// given order, so that the same seed gives the same sample. If weighted is set, ids are picked proportionally to their
+// weights and ids with weights lower than one are never picked.
+func Sample(rng *rand.Rand, ids []int64, weight func(id int64) int64, count int64, weighted bool, exclude map[int64]struct{}) []int64 {
+	candidates := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := exclude[id]; ok {
+			continue
+		}
+		// names which were never given can't be picked proportionally to their number of occurrences
+		if weighted && weight(id) <= 0 {
+			continue
+		}
+		candidates = append(candidates, id)
+	}
+	if count > int64(len(candidates)) {
+		count = int64(len(candidates))
+	}
+
+	if !weighted {
+		// partial Fisher-Yates shuffle
+		for i := 0; i < int(count); i++ {
+			j := i + rng.Intn(len(candidates)-i)
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		}
+		return candidates[:count]
+	}
+
+	// weighted sampling without replacement (Efraimidis-Spirakis), every candidate gets a random key which tends to be
+	// higher for higher weights and the candidates with the highest keys are picked
+	keys := make(map[int64]float64, len(candidates))
+	for _, id := range candidates {
+		keys[id] = math.Log(rng.Float64()) / float64(weight(id))
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return keys[candidates[i]] > keys[candidates[j]] })
+	return candidates[:count]
+}
@@ -0,0 +1,263 @@
+// Package sessions lets two participants, e.g. parents, vote on names independently and finds names both of them
+// like.
+package sessions
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+var ErrUnknownParticipant = errors.New("invite token doesn't belong to the session")
+
+// participants is the number of participants of every session
+const participants = 2
+
+// namesBatchSize is the number of names fetched at once while looking for a name a participant hasn't voted on
+const namesBatchSize = 100
+
+// subscriberBuffer is the number of matches which can wait for a subscriber, slower subscribers are dropped
+const subscriberBuffer = 16
+
+type Service struct {
+	store        ports.SessionsStore
+	namesService ports.NamesService
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan *models.Match]struct{}
+	closed      bool
+}
+
+func NewService(store ports.SessionsStore, namesService ports.NamesService) *Service {
+	return &Service{
+		store:        store,
+		namesService: namesService,
+		subscribers:  make(map[string]map[chan *models.Match]struct{}),
+	}
+}
+
+type nameKey struct {
+	value  string
+	gender models.Gender
+}
+
+func keyOf(value string, gender models.Gender) nameKey {
+	return nameKey{value: strings.ToUpper(value), gender: gender}
+}
+
+// Create starts a session serving names from a given year, of a given gender or of both genders if gender is empty
+func (s *Service) Create(ctx context.Context, year int64, gender models.Gender) (*models.Session, error) {
+	return s.store.CreateSession(ctx, year, gender, participants)
+}
+
+// Get returns a session to one of its participants
+func (s *Service) Get(ctx context.Context, id string, token string) (*models.Session, error) {
+	session, _, err := s.authorize(ctx, id, token)
+	return session, err
+}
+
+// NextName returns the first name, ordered by id, the participant hasn't voted on yet, or nil if the participant voted
+// on all names
+func (s *Service) NextName(ctx context.Context, id string, token string) (*models.Name, error) {
+	session, participant, err := s.authorize(ctx, id, token)
+	if err != nil {
+		return nil, err
+	}
+	voted := make(map[nameKey]struct{})
+	for _, vote := range session.Participants[participant].Votes {
+		voted[keyOf(vote.Value, vote.Gender)] = struct{}{}
+	}
+
+	afterId := int64(-1)
+	for {
+		names, err := s.namesService.GetNamesAfter(ctx, session.Year, session.Gender, afterId, namesBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get names: %w", err)
+		}
+		if len(names) == 0 {
+			return nil, nil
+		}
+		for _, name := range names {
+			if _, ok := voted[keyOf(name.Value, name.Gender)]; !ok {
+				return name, nil
+			}
+		}
+		afterId = names[len(names)-1].Id
+	}
+}
+
+// Vote records whether the participant likes a name with a given id in the year of the session. A new match is
+// returned if the vote made all participants like the name, subscribers of the session are notified about it.
+func (s *Service) Vote(ctx context.Context, id string, token string, nameId int64, like bool) (*models.Match, error) {
+	session, participant, err := s.authorize(ctx, id, token)
+	if err != nil {
+		return nil, err
+	}
+	name, err := s.namesService.GetName(ctx, session.Year, nameId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get name: %w", err)
+	}
+	if session.Gender != "" && name.Gender != session.Gender {
+		return nil, ports.ErrNameNotFound
+	}
+	key := keyOf(name.Value, name.Gender)
+	for _, vote := range session.Participants[participant].Votes {
+		// repeated votes are ignored, so that they don't change when names were matched
+		if keyOf(vote.Value, vote.Gender) == key && vote.Like == like {
+			return nil, nil
+		}
+	}
+	_, alreadyMatched := matches(session)[key]
+
+	session, err = s.store.RecordVote(ctx, id, participant, &models.Vote{
+		Value:   name.Value,
+		Gender:  name.Gender,
+		Like:    like,
+		VotedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record vote: %w", err)
+	}
+	match, matched := matches(session)[key]
+	if !matched || alreadyMatched {
+		return nil, nil
+	}
+	s.publish(id, match)
+
+	return match, nil
+}
+
+// Matches returns names liked by all participants, ordered by when they were matched
+func (s *Service) Matches(ctx context.Context, id string, token string) ([]*models.Match, error) {
+	session, _, err := s.authorize(ctx, id, token)
+	if err != nil {
+		return nil, err
+	}
+	var result []*models.Match
+	for _, match := range matches(session) {
+		result = append(result, match)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].MatchedAt.Equal(result[j].MatchedAt) {
+			return result[i].MatchedAt.Before(result[j].MatchedAt)
+		}
+		return result[i].Value < result[j].Value
+	})
+	return result, nil
+}
+
+// Subscribe returns a channel which receives new matches of a session. The channel is closed when the context is
+// done, when the service is closed, or when the subscriber doesn't keep up with matches.
+func (s *Service) Subscribe(ctx context.Context, id string, token string) (<-chan *models.Match, error) {
+	if _, _, err := s.authorize(ctx, id, token); err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *models.Match, subscriberBuffer)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		close(ch)
+		return ch, nil
+	}
+	if s.subscribers[id] == nil {
+		s.subscribers[id] = make(map[chan *models.Match]struct{})
+	}
+	s.subscribers[id][ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.unsubscribe(id, ch)
+	}()
+
+	return ch, nil
+}
+
+// Close closes channels of all subscribers, e.g. so that long-lived streams of matches end when a server shuts down
+func (s *Service) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for id, subscribers := range s.subscribers {
+		for ch := range subscribers {
+			s.unsubscribe(id, ch)
+		}
+	}
+}
+
+func (s *Service) publish(id string, match *models.Match) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.subscribers[id] {
+		select {
+		case ch <- match:
+		default:
+			s.unsubscribe(id, ch)
+		}
+	}
+}
+
+// unsubscribe closes a channel unless it has been closed already. The caller must hold the lock.
+func (s *Service) unsubscribe(id string, ch chan *models.Match) {
+	if _, ok := s.subscribers[id][ch]; !ok {
+		return
+	}
+	delete(s.subscribers[id], ch)
+	if len(s.subscribers[id]) == 0 {
+		delete(s.subscribers, id)
+	}
+	close(ch)
+}
+
+// authorize returns a session and the position of the participant with a given invite token
+func (s *Service) authorize(ctx context.Context, id string, token string) (*models.Session, int, error) {
+	session, err := s.store.GetSession(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i, participant := range session.Participants {
+		if subtle.ConstantTimeCompare([]byte(participant.Token), []byte(token)) == 1 {
+			return session, i, nil
+		}
+	}
+	return nil, 0, ErrUnknownParticipant
+}
+
+// matches returns names which are liked by all participants of a session
+func matches(session *models.Session) map[nameKey]*models.Match {
+	likes := make(map[nameKey]*models.Match)
+	counts := make(map[nameKey]int)
+	for _, participant := range session.Participants {
+		for _, vote := range participant.Votes {
+			if !vote.Like {
+				continue
+			}
+			key := keyOf(vote.Value, vote.Gender)
+			counts[key]++
+			match, ok := likes[key]
+			if !ok {
+				match = &models.Match{Value: vote.Value, Gender: vote.Gender}
+				likes[key] = match
+			}
+			if vote.VotedAt.After(match.MatchedAt) {
+				match.MatchedAt = vote.VotedAt
+			}
+		}
+	}
+	for key := range likes {
+		if counts[key] < len(session.Participants) {
+			delete(likes, key)
+		}
+	}
+	return likes
+}
@@ -0,0 +1,167 @@
+// Package tournaments ranks names from a shortlist by letting participants pick the better of two names at a time.
+// Contenders are rated with the Elo rating system, separately for every participant.
+//
+// Unlike sessions, tournaments don't authenticate participants. The unguessable ID of a tournament is the only secret,
+// everyone who knows it is trusted to vote, and a participant is just a label chosen by whoever votes, so that
+// rankings of different people can be told apart. Anyone with the ID can vote under any label, including one used by
+// someone else, and so change their ranking and the overall leaderboard.
+package tournaments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+var ErrNotEnoughContenders = errors.New("a tournament needs at least two names")
+var ErrUnknownContender = errors.New("winner and loser have to be two different names of the tournament")
+var ErrMissingParticipant = errors.New("participant is required")
+
+const (
+	// InitialRating is the rating of a contender which hasn't played yet
+	InitialRating = 1500
+	// kFactor is the maximum change of a rating after a single game
+	kFactor = 32
+)
+
+type Service struct {
+	store             ports.TournamentsStore
+	favouritesService ports.FavouritesService
+}
+
+func NewService(store ports.TournamentsStore, favouritesService ports.FavouritesService) *Service {
+	return &Service{
+		store:             store,
+		favouritesService: favouritesService,
+	}
+}
+
+// Create starts a tournament between names which are on a shortlist now, names added to the shortlist later don't
+// take part in it
+func (s *Service) Create(ctx context.Context, shortlistId string) (*models.Tournament, error) {
+	shortlist, err := s.favouritesService.GetShortlist(ctx, shortlistId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shortlist: %w", err)
+	}
+	if len(shortlist.Names) < 2 {
+		return nil, ErrNotEnoughContenders
+	}
+	var contenders []*models.Contender
+	for _, entry := range shortlist.Names {
+		contenders = append(contenders, &models.Contender{
+			Value:  entry.Value,
+			Gender: entry.Gender,
+		})
+	}
+	return s.store.CreateTournament(ctx, shortlistId, contenders)
+}
+
+func (s *Service) Get(ctx context.Context, id string) (*models.Tournament, error) {
+	return s.store.GetTournament(ctx, id)
+}
+
+// Pair returns two contenders for a participant to compare. The contender which played the fewest games of the
+// participant is picked first, its opponent is the one with the closest rating, so that every game is informative.
+func (s *Service) Pair(ctx context.Context, id string, participant string) (*models.Standing, *models.Standing, error) {
+	if participant == "" {
+		return nil, nil, ErrMissingParticipant
+	}
+	tournament, err := s.store.GetTournament(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	standings := rate(tournament, participant)
+
+	// ties are broken randomly, so that participants don't see the same pairs over and over
+	order := rand.Perm(len(standings))
+	first := standings[order[0]]
+	for _, i := range order[1:] {
+		if standings[i].Games < first.Games {
+			first = standings[i]
+		}
+	}
+	var second *models.Standing
+	for _, i := range order {
+		if standings[i] == first {
+			continue
+		}
+		if second == nil || math.Abs(standings[i].Rating-first.Rating) < math.Abs(second.Rating-first.Rating) {
+			second = standings[i]
+		}
+	}
+
+	return first, second, nil
+}
+
+// Vote records that a participant prefers the winner to the loser and returns their new standings
+func (s *Service) Vote(ctx context.Context, id string, participant string, winner int, loser int) (*models.Standing, *models.Standing, error) {
+	if participant == "" {
+		return nil, nil, ErrMissingParticipant
+	}
+	tournament, err := s.store.GetTournament(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if winner == loser || winner < 0 || loser < 0 || winner >= len(tournament.Contenders) || loser >= len(tournament.Contenders) {
+		return nil, nil, ErrUnknownContender
+	}
+
+	tournament, err = s.store.RecordGame(ctx, id, &models.Game{
+		Participant: participant,
+		Winner:      winner,
+		Loser:       loser,
+		PlayedAt:    time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to record game: %w", err)
+	}
+	standings := rate(tournament, participant)
+
+	return standings[winner], standings[loser], nil
+}
+
+// Leaderboard returns contenders ordered by their rating, the best first. Ratings are computed from games of a given
+// participant, or from games of all participants if participant is empty.
+func (s *Service) Leaderboard(ctx context.Context, id string, participant string) ([]*models.Standing, error) {
+	tournament, err := s.store.GetTournament(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	standings := rate(tournament, participant)
+	sort.SliceStable(standings, func(i, j int) bool { return standings[i].Rating > standings[j].Rating })
+
+	return standings, nil
+}
+
+// rate replays games of a participant, or of all participants if participant is empty, and returns standings of
+// contenders indexed by their position in the tournament
+func rate(tournament *models.Tournament, participant string) []*models.Standing {
+	standings := make([]*models.Standing, len(tournament.Contenders))
+	for i, contender := range tournament.Contenders {
+		standings[i] = &models.Standing{
+			Id:        i,
+			Contender: contender,
+			Rating:    InitialRating,
+		}
+	}
+	for _, game := range tournament.Games {
+		if participant != "" && game.Participant != participant {
+			continue
+		}
+		winner, loser := standings[game.Winner], standings[game.Loser]
+		expected := 1 / (1 + math.Pow(10, (loser.Rating-winner.Rating)/400))
+		winner.Rating += kFactor * (1 - expected)
+		loser.Rating -= kFactor * (1 - expected)
+		winner.Games++
+		winner.Wins++
+		loser.Games++
+	}
+	return standings
+}
@@ -0,0 +1,157 @@
+package tournaments
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+func TestRate(t *testing.T) {
+	tests := []struct {
+		name        string
+		games       []*models.Game
+		participant string
+		wantRatings []float64
+		wantGames   []int64
+		wantWins    []int64
+	}{
+		{
+			name:        "no games",
+			participant: "mum",
+			wantRatings: []float64{1500, 1500, 1500},
+			wantGames:   []int64{0, 0, 0},
+			wantWins:    []int64{0, 0, 0},
+		},
+		{
+			name:        "equal ratings move by half of K",
+			games:       []*models.Game{{Participant: "mum", Winner: 0, Loser: 1}},
+			participant: "mum",
+			wantRatings: []float64{1516, 1484, 1500},
+			wantGames:   []int64{1, 1, 0},
+			wantWins:    []int64{1, 0, 0},
+		},
+		{
+			name: "expected win moves ratings less",
+			games: []*models.Game{
+				{Participant: "mum", Winner: 0, Loser: 1},
+				{Participant: "mum", Winner: 0, Loser: 1},
+			},
+			participant: "mum",
+			wantRatings: []float64{1530.5305, 1469.4695, 1500},
+			wantGames:   []int64{2, 2, 0},
+			wantWins:    []int64{2, 0, 0},
+		},
+		{
+			name: "upset moves ratings more",
+			games: []*models.Game{
+				{Participant: "mum", Winner: 0, Loser: 1},
+				{Participant: "mum", Winner: 1, Loser: 0},
+			},
+			participant: "mum",
+			wantRatings: []float64{1498.5305, 1501.4695, 1500},
+			wantGames:   []int64{2, 2, 0},
+			wantWins:    []int64{1, 1, 0},
+		},
+		{
+			name: "games of other participants are ignored",
+			games: []*models.Game{
+				{Participant: "mum", Winner: 0, Loser: 1},
+				{Participant: "dad", Winner: 2, Loser: 0},
+			},
+			participant: "mum",
+			wantRatings: []float64{1516, 1484, 1500},
+			wantGames:   []int64{1, 1, 0},
+			wantWins:    []int64{1, 0, 0},
+		},
+		{
+			name: "games of all participants are replayed in order",
+			games: []*models.Game{
+				{Participant: "mum", Winner: 0, Loser: 1},
+				{Participant: "dad", Winner: 0, Loser: 1},
+			},
+			wantRatings: []float64{1530.5305, 1469.4695, 1500},
+			wantGames:   []int64{2, 2, 0},
+			wantWins:    []int64{2, 0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standings := rate(newTournament(3, tt.games), tt.participant)
+			for i, standing := range standings {
+				if standing.Id != i {
+					t.Errorf("expected id %d at position %d, got %d", i, i, standing.Id)
+				}
+				if math.Abs(standing.Rating-tt.wantRatings[i]) > 0.0001 {
+					t.Errorf("expected rating %.4f of contender %d, got %.4f", tt.wantRatings[i], i, standing.Rating)
+				}
+				if standing.Games != tt.wantGames[i] || standing.Wins != tt.wantWins[i] {
+					t.Errorf("expected %d games and %d wins of contender %d, got %d and %d", tt.wantGames[i], tt.wantWins[i], i, standing.Games, standing.Wins)
+				}
+			}
+		})
+	}
+}
+
+func TestPair(t *testing.T) {
+	// for mum, contender 3 hasn't played yet, as the game of dad doesn't count, and contender 2 is the closest to its
+	// initial rating
+	games := []*models.Game{
+		{Participant: "mum", Winner: 0, Loser: 1},
+		{Participant: "mum", Winner: 0, Loser: 2},
+		{Participant: "dad", Winner: 3, Loser: 2},
+	}
+	service := NewService(&tournamentsStore{tournament: newTournament(4, games)}, nil)
+
+	tests := []struct {
+		name        string
+		participant string
+		wantFirst   int
+		wantSecond  int
+		wantErr     error
+	}{
+		{name: "fewest games first, closest rating second", participant: "mum", wantFirst: 3, wantSecond: 2},
+		{name: "missing participant", wantErr: ErrMissingParticipant},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ties are broken randomly, pairs without ties have to be the same every time
+			for i := 0; i < 20; i++ {
+				first, second, err := service.Pair(context.Background(), "id", tt.participant)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if err != nil {
+					return
+				}
+				if first.Id != tt.wantFirst || second.Id != tt.wantSecond {
+					t.Fatalf("expected pair %d and %d, got %d and %d", tt.wantFirst, tt.wantSecond, first.Id, second.Id)
+				}
+			}
+		})
+	}
+}
+
+func newTournament(contenders int, games []*models.Game) *models.Tournament {
+	tournament := &models.Tournament{Id: "id", Games: games}
+	for i := 0; i < contenders; i++ {
+		tournament.Contenders = append(tournament.Contenders, &models.Contender{Value: string(rune('A' + i)), Gender: models.GenderMale})
+	}
+	return tournament
+}
+
+// tournamentsStore serves a single tournament
+type tournamentsStore struct {
+	ports.TournamentsStore
+	tournament *models.Tournament
+}
+
+func (s *tournamentsStore) GetTournament(ctx context.Context, id string) (*models.Tournament, error) {
+	if id != s.tournament.Id {
+		return nil, ports.ErrTournamentNotFound
+	}
+	return s.tournament, nil
+}
@@ -0,0 +1,178 @@
+// Package trends compares popularity of names between two years.
+package trends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mwasilew2/go-service-template/internal/domain/models"
+	"github.com/mwasilew2/go-service-template/internal/domain/ports"
+)
+
+var ErrSameYears = errors.New("years to compare have to be different")
+var ErrUnknownMetric = errors.New("unknown metric")
+
+// Metric is what risers and fallers are ordered by
+type Metric string
+
+const (
+	ByRank  Metric = "rank"
+	ByShare Metric = "share"
+)
+
+type Service struct {
+	namesService ports.NamesService
+}
+
+func NewService(namesService ports.NamesService) *Service {
+	return &Service{
+		namesService: namesService,
+	}
+}
+
+type trendKey struct {
+	value  string
+	gender models.Gender
+}
+
+type yearSnapshot struct {
+	names map[trendKey]*models.Name
+	// total number of occurrences of all names of a given gender
+	totals map[models.Gender]int64
+}
+
+// Report compares two years. Names given in both years are split into risers and fallers and ordered by the change
+// of a given metric, the biggest change first. Names given only in one of the years are new entrants or dropouts,
+// ordered by the number of occurrences. Every list has at most limit entries.
+func (s *Service) Report(ctx context.Context, from int64, to int64, metric Metric, limit int64) (*models.TrendsReport, error) {
+	if from == to {
+		return nil, ErrSameYears
+	}
+	if metric != ByRank && metric != ByShare {
+		return nil, ErrUnknownMetric
+	}
+	fromSnapshot, err := s.snapshot(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get names from %d: %w", from, err)
+	}
+	toSnapshot, err := s.snapshot(ctx, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get names from %d: %w", to, err)
+	}
+
+	report := &models.TrendsReport{
+		From: from,
+		To:   to,
+	}
+	for key, toName := range toSnapshot.names {
+		fromName, ok := fromSnapshot.names[key]
+		if !ok {
+			report.NewEntrants = append(report.NewEntrants, &models.Trend{
+				Value:  toName.Value,
+				Gender: key.gender,
+				To:     toName,
+			})
+			continue
+		}
+		trend := &models.Trend{
+			Value:       toName.Value,
+			Gender:      key.gender,
+			From:        fromName,
+			To:          toName,
+			RankChange:  fromName.Rank - toName.Rank,
+			ShareChange: share(toName, toSnapshot) - share(fromName, fromSnapshot),
+		}
+		switch change := change(trend, metric); {
+		case change > 0:
+			report.Risers = append(report.Risers, trend)
+		case change < 0:
+			report.Fallers = append(report.Fallers, trend)
+		}
+	}
+	for key, fromName := range fromSnapshot.names {
+		if _, ok := toSnapshot.names[key]; !ok {
+			report.Dropouts = append(report.Dropouts, &models.Trend{
+				Value:  fromName.Value,
+				Gender: key.gender,
+				From:   fromName,
+			})
+		}
+	}
+
+	// order and truncate the lists, ties are broken by the name so that reports are reproducible
+	sortTrends(report.Risers, func(t *models.Trend) float64 { return change(t, metric) })
+	sortTrends(report.Fallers, func(t *models.Trend) float64 { return -change(t, metric) })
+	sortTrends(report.NewEntrants, func(t *models.Trend) float64 { return float64(t.To.Count) })
+	sortTrends(report.Dropouts, func(t *models.Trend) float64 { return float64(t.From.Count) })
+	report.Risers = truncate(report.Risers, limit)
+	report.Fallers = truncate(report.Fallers, limit)
+	report.NewEntrants = truncate(report.NewEntrants, limit)
+	report.Dropouts = truncate(report.Dropouts, limit)
+
+	return report, nil
+}
+
+// snapshot gets all names given in a year
+func (s *Service) snapshot(ctx context.Context, year int64) (*yearSnapshot, error) {
+	snapshot := &yearSnapshot{
+		names:  make(map[trendKey]*models.Name),
+		totals: make(map[models.Gender]int64),
+	}
+	for _, gender := range []models.Gender{models.GenderMale, models.GenderFemale} {
+		count, err := s.namesService.GetNoOfEntriesByGender(ctx, year, gender)
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			continue
+		}
+		names, err := s.namesService.GetPageByGender(ctx, year, gender, 0, count)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			snapshot.names[trendKey{value: strings.ToUpper(name.Value), gender: gender}] = name
+			snapshot.totals[gender] += name.Count
+		}
+	}
+	return snapshot, nil
+}
+
+// share returns the fraction of all occurrences of names of the same gender in a year
+func share(name *models.Name, snapshot *yearSnapshot) float64 {
+	total := snapshot.totals[name.Gender]
+	if total == 0 {
+		return 0
+	}
+	return float64(name.Count) / float64(total)
+}
+
+func change(trend *models.Trend, metric Metric) float64 {
+	if metric == ByShare {
+		return trend.ShareChange
+	}
+	return float64(trend.RankChange)
+}
+
+// sortTrends orders trends by a key, the biggest first
+func sortTrends(trends []*models.Trend, key func(*models.Trend) float64) {
+	sort.Slice(trends, func(i, j int) bool {
+		if ki, kj := key(trends[i]), key(trends[j]); ki != kj {
+			return ki > kj
+		}
+		if trends[i].Value != trends[j].Value {
+			return trends[i].Value < trends[j].Value
+		}
+		return trends[i].Gender < trends[j].Gender
+	})
+}
+
+func truncate(trends []*models.Trend, limit int64) []*models.Trend {
+	if int64(len(trends)) > limit {
+		return trends[:limit]
+	}
+	return trends
+}